@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"fmt"
+)
+
+// First season PFR charted air yards, pressures, broken tackles, etc.
+const firstAdvancedStatsYear = 2018
+
+type AdvancedPassing struct {
+	PlayerSeasonInfo
+	Attempts                int     `json:"attempts"`
+	Completions             int     `json:"completions"`
+	Yards                   int     `json:"yards"`
+	IntendedAirYards        int     `json:"intendedAirYards"`
+	IntendedAirYardsPerAtt  float64 `json:"intendedAirYardsPerAtt"`
+	CompletedAirYards       int     `json:"completedAirYards"`
+	CompletedAirYardsPerCmp float64 `json:"completedAirYardsPerCmp"`
+	YardsAfterCatch         int     `json:"yardsAfterCatch"`
+	YardsAfterCatchPerCmp   float64 `json:"yardsAfterCatchPerCmp"`
+	Drops                   int     `json:"drops"`
+	DropPerc                float64 `json:"dropPerc"`
+	BadThrows               int     `json:"badThrows"`
+	BadThrowPerc            float64 `json:"badThrowPerc"`
+	OnTargetPerc            float64 `json:"onTargetPerc"`
+	Dropbacks               int     `json:"dropbacks"`
+	Blitzed                 int     `json:"blitzed"`
+	BlitzPerc               float64 `json:"blitzPerc"`
+	Hurried                 int     `json:"hurried"`
+	Hit                     int     `json:"hit"`
+	Pressured               int     `json:"pressured"`
+	PressurePerc            float64 `json:"pressurePerc"`
+	Scrambles               int     `json:"scrambles"`
+}
+
+type AdvancedRushing struct {
+	PlayerSeasonInfo
+	Attempts                 int     `json:"attempts"`
+	Yards                    int     `json:"yards"`
+	TDs                      int     `json:"tds"`
+	YardsBeforeContact       int     `json:"yardsBeforeContact"`
+	YardsBeforeContactPerAtt float64 `json:"yardsBeforeContactPerAtt"`
+	YardsAfterContact        int     `json:"yardsAfterContact"`
+	YardsAfterContactPerAtt  float64 `json:"yardsAfterContactPerAtt"`
+	BrokenTackles            int     `json:"brokenTackles"`
+	AttemptsPerBrokenTackle  float64 `json:"attemptsPerBrokenTackle"`
+}
+
+type AdvancedReceiving struct {
+	PlayerSeasonInfo
+	Targets                   int     `json:"targets"`
+	Receptions                int     `json:"receptions"`
+	Yards                     int     `json:"yards"`
+	TDs                       int     `json:"tds"`
+	AirYards                  int     `json:"airYards"`
+	AirYardsPerRec            float64 `json:"airYardsPerRec"`
+	YardsAfterCatch           int     `json:"yardsAfterCatch"`
+	YardsAfterCatchPerRec     float64 `json:"yardsAfterCatchPerRec"`
+	AverageDepthOfTarget      float64 `json:"averageDepthOfTarget"`
+	BrokenTackles             int     `json:"brokenTackles"`
+	ReceptionsPerBrokenTackle float64 `json:"receptionsPerBrokenTackle"`
+	Drops                     int     `json:"drops"`
+	DropPerc                  float64 `json:"dropPerc"`
+	Ints                      int     `json:"ints"`
+	Rating                    float64 `json:"rating"`
+}
+
+type AdvancedDefense struct {
+	PlayerSeasonInfo
+	Ints                 int     `json:"ints"`
+	Targets              int     `json:"targets"`
+	CompletionsAllowed   int     `json:"completionsAllowed"`
+	CompletionPerc       float64 `json:"completionPerc"`
+	YardsAllowed         int     `json:"yardsAllowed"`
+	YardsPerTarget       float64 `json:"yardsPerTarget"`
+	TDsAllowed           int     `json:"tdsAllowed"`
+	RatingAllowed        float64 `json:"ratingAllowed"`
+	AverageDepthOfTarget float64 `json:"averageDepthOfTarget"`
+	AirYardsAllowed      int     `json:"airYardsAllowed"`
+	YardsAfterCatch      int     `json:"yardsAfterCatch"`
+	Blitzes              int     `json:"blitzes"`
+	Hurries              int     `json:"hurries"`
+	QBKnockdowns         int     `json:"qbKnockdowns"`
+	Sacks                float64 `json:"sacks"`
+	Pressures            int     `json:"pressures"`
+	CombinedTackles      int     `json:"combinedTackles"`
+	MissedTackles        int     `json:"missedTackles"`
+	MissedTacklePerc     float64 `json:"missedTacklePerc"`
+}
+
+type AdvancedStats struct {
+	Category string `json:"category"`
+	Year     int    `json:"year"`
+	Team     string `json:"team,omitempty"`
+	Warning  string `json:"warning,omitempty"`
+	Players  any    `json:"players"`
+}
+
+// Category -> PFR table ids, passing is split over several tables which are merged per player
+var advancedCategories = map[string][]string{
+	"passing":   {"passing_air", "passing_accuracy", "passing_pressure"},
+	"rushing":   {"advanced_rushing", "rushing_advanced"},
+	"receiving": {"advanced_receiving", "receiving_advanced"},
+	"defense":   {"advanced_defense", "defense_advanced"},
+}
+
+/*
+Gets advanced tables for a category, from either a league page (years/{year}/{category}_advanced.htm)
+or a team page (teams/{team}/{year}_advanced.htm), team is left empty for league pages
+Years before 2018 return a warning without fetching since PFR has no advanced data for them
+*/
+func GetAdvancedStats(url string, category string, year int, team string) (AdvancedStats, error) {
+	tableIds, exists := advancedCategories[category]
+	if !exists {
+		return AdvancedStats{}, fmt.Errorf("unknown category %s", category)
+	}
+
+	res := AdvancedStats{
+		Category: category,
+		Year:     year,
+		Team:     team,
+		Players:  []any{},
+	}
+
+	if year < firstAdvancedStatsYear {
+		res.Warning = fmt.Sprintf("advanced %s stats are only available from %d on", category, firstAdvancedStatsYear)
+		return res, nil
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return AdvancedStats{}, err
+	}
+
+	// Merge every table of the category into one row per player (and team, for traded players)
+	var merged []tableRow
+	mergedIndex := map[string]int{}
+	for _, tableId := range tableIds {
+		for _, row := range readTableRows(findTable(doc, tableId)) {
+			info, ok := parsePlayerSeasonInfo(row)
+			if !ok {
+				continue
+			}
+
+			key := info.PlayerId + "|" + info.Team
+			i, exists := mergedIndex[key]
+			if !exists {
+				mergedIndex[key] = len(merged)
+				merged = append(merged, row)
+				continue
+			}
+			for stat, value := range row {
+				if _, exists := merged[i][stat]; !exists {
+					merged[i][stat] = value
+				}
+			}
+		}
+	}
+
+	if len(merged) == 0 {
+		res.Warning = fmt.Sprintf("no advanced %s data found for %d", category, year)
+		return res, nil
+	}
+
+	switch category {
+	case "passing":
+		players := []AdvancedPassing{}
+		for _, row := range merged {
+			info, _ := parsePlayerSeasonInfo(row)
+
+			dropbacks := row.intStat("pass_dropbacks")
+			var blitzPerc float64
+			if dropbacks > 0 {
+				blitzPerc = float64(row.intStat("pass_blitzed")) / float64(dropbacks)
+			}
+
+			players = append(players, AdvancedPassing{
+				PlayerSeasonInfo:        info,
+				Attempts:                row.intStat("pass_att"),
+				Completions:             row.intStat("pass_cmp"),
+				Yards:                   row.intStat("pass_yds"),
+				IntendedAirYards:        row.intStat("pass_target_yds", "pass_tgt_yds"),
+				IntendedAirYardsPerAtt:  row.floatStat("pass_tgt_yds_per_att"),
+				CompletedAirYards:       row.intStat("pass_air_yds"),
+				CompletedAirYardsPerCmp: row.floatStat("pass_air_yds_per_cmp"),
+				YardsAfterCatch:         row.intStat("pass_yac"),
+				YardsAfterCatchPerCmp:   row.floatStat("pass_yac_per_cmp"),
+				Drops:                   row.intStat("pass_drops"),
+				DropPerc:                row.floatStat("pass_drop_pct") / 100,
+				BadThrows:               row.intStat("pass_poor_throws"),
+				BadThrowPerc:            row.floatStat("pass_poor_throw_pct") / 100,
+				OnTargetPerc:            row.floatStat("pass_on_target_pct") / 100,
+				Dropbacks:               dropbacks,
+				Blitzed:                 row.intStat("pass_blitzed"),
+				BlitzPerc:               blitzPerc,
+				Hurried:                 row.intStat("pass_hurried"),
+				Hit:                     row.intStat("pass_hits"),
+				Pressured:               row.intStat("pass_pressured"),
+				PressurePerc:            row.floatStat("pass_pressured_pct") / 100,
+				Scrambles:               row.intStat("rush_scrambles", "pass_scrambles"),
+			})
+		}
+		res.Players = players
+	case "rushing":
+		players := []AdvancedRushing{}
+		for _, row := range merged {
+			info, _ := parsePlayerSeasonInfo(row)
+			players = append(players, AdvancedRushing{
+				PlayerSeasonInfo:         info,
+				Attempts:                 row.intStat("rush_att"),
+				Yards:                    row.intStat("rush_yds"),
+				TDs:                      row.intStat("rush_td"),
+				YardsBeforeContact:       row.intStat("rush_yds_before_contact"),
+				YardsBeforeContactPerAtt: row.floatStat("rush_yds_bc_per_rush"),
+				YardsAfterContact:        row.intStat("rush_yac"),
+				YardsAfterContactPerAtt:  row.floatStat("rush_yac_per_rush"),
+				BrokenTackles:            row.intStat("rush_broken_tackles"),
+				AttemptsPerBrokenTackle:  row.floatStat("rush_broken_tackles_per_rush"),
+			})
+		}
+		res.Players = players
+	case "receiving":
+		players := []AdvancedReceiving{}
+		for _, row := range merged {
+			info, _ := parsePlayerSeasonInfo(row)
+			players = append(players, AdvancedReceiving{
+				PlayerSeasonInfo:          info,
+				Targets:                   row.intStat("targets"),
+				Receptions:                row.intStat("rec"),
+				Yards:                     row.intStat("rec_yds"),
+				TDs:                       row.intStat("rec_td"),
+				AirYards:                  row.intStat("rec_air_yds"),
+				AirYardsPerRec:            row.floatStat("rec_air_yds_per_rec"),
+				YardsAfterCatch:           row.intStat("rec_yac"),
+				YardsAfterCatchPerRec:     row.floatStat("rec_yac_per_rec"),
+				AverageDepthOfTarget:      row.floatStat("rec_adot"),
+				BrokenTackles:             row.intStat("rec_broken_tackles"),
+				ReceptionsPerBrokenTackle: row.floatStat("rec_broken_tackles_per_rec"),
+				Drops:                     row.intStat("rec_drops"),
+				DropPerc:                  row.floatStat("rec_drop_pct") / 100,
+				Ints:                      row.intStat("rec_target_int"),
+				Rating:                    row.floatStat("rec_pass_rating"),
+			})
+		}
+		res.Players = players
+	case "defense":
+		players := []AdvancedDefense{}
+		for _, row := range merged {
+			info, _ := parsePlayerSeasonInfo(row)
+			players = append(players, AdvancedDefense{
+				PlayerSeasonInfo:     info,
+				Ints:                 row.intStat("def_int"),
+				Targets:              row.intStat("def_targets"),
+				CompletionsAllowed:   row.intStat("def_cmp"),
+				CompletionPerc:       row.floatStat("def_cmp_perc") / 100,
+				YardsAllowed:         row.intStat("def_cmp_yds"),
+				YardsPerTarget:       row.floatStat("def_yds_per_target"),
+				TDsAllowed:           row.intStat("def_cmp_td"),
+				RatingAllowed:        row.floatStat("def_pass_rating"),
+				AverageDepthOfTarget: row.floatStat("def_tgt_yds_per_att"),
+				AirYardsAllowed:      row.intStat("def_air_yds"),
+				YardsAfterCatch:      row.intStat("def_yac"),
+				Blitzes:              row.intStat("blitzes"),
+				Hurries:              row.intStat("qb_hurry"),
+				QBKnockdowns:         row.intStat("qb_knockdown"),
+				Sacks:                row.floatStat("sacks"),
+				Pressures:            row.intStat("pressures"),
+				CombinedTackles:      row.intStat("tackles_combined"),
+				MissedTackles:        row.intStat("tackles_missed"),
+				MissedTacklePerc:     row.floatStat("tackles_missed_pct") / 100,
+			})
+		}
+		res.Players = players
+	}
+
+	return res, nil
+}
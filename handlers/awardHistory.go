@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Gets every winner of an award between from and to (inclusive), see awardIds for known ids
+func GetAwardHistory(url string, awardId string, from int, to int) ([]AwardWinner, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []AwardWinner{}, err
+	}
+
+	// Award pages hold a single winners table, its id varies by award
+	tableId, _ := doc.Find("table[id]").First().Attr("id")
+	awardName := strings.TrimSpace(doc.Find("#meta h1").First().Text())
+
+	winners := []AwardWinner{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		year := row.intStat("year_id")
+		if year == 0 || year < from || year > to {
+			continue
+		}
+
+		winner := AwardWinner{
+			Year:      year,
+			AwardId:   awardId,
+			Award:     awardName,
+			Winner:    row.name("player", "coach"),
+			PlayerId:  row["player_id"],
+			Team:      teamFromHref(row.first("team_href", "team_name_abbr_href")),
+			Position:  row["pos"],
+			VoteShare: row.floatStat("voting_share", "share"),
+		}
+		if winner.PlayerId == "" && strings.Contains(row["player_href"], "/players/") {
+			winner.PlayerId = idFromHref(row["player_href"])
+		}
+		if strings.Contains(row["coach_href"], "/coaches/") {
+			winner.CoachId = idFromHref(row["coach_href"])
+		}
+
+		winners = append(winners, winner)
+	}
+
+	if len(winners) == 0 {
+		return []AwardWinner{}, fmt.Errorf("no winners found for %s between %d and %d", awardId, from, to)
+	}
+
+	return winners, nil
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type Weather struct {
+	Temperature int    `json:"temperature"` // degrees F
+	Humidity    int    `json:"humidity"`    // percent
+	WindSpeed   int    `json:"windSpeed"`   // mph
+	WindChill   int    `json:"windChill,omitempty"`
+	Description string `json:"description"`
+}
+
+type GameConditions struct {
+	StadiumId string   `json:"stadiumId"`
+	Stadium   string   `json:"stadium"`
+	Roof      string   `json:"roof"`
+	Surface   string   `json:"surface"`
+	Weather   *Weather `json:"weather"` // nil for domes and games PFR has no weather for
+}
+
+var (
+	temperatureRegex = regexp.MustCompile(`(-?\d+) degrees`)
+	humidityRegex    = regexp.MustCompile(`humidity (\d+)%`)
+	windRegex        = regexp.MustCompile(`wind (\d+) mph`)
+	windChillRegex   = regexp.MustCompile(`wind chill (-?\d+)`)
+)
+
+// Gets stadium, roof, surface and weather for a game from its boxscore
+func GetGameConditions(url string) (GameConditions, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return GameConditions{}, err
+	}
+
+	return parseGameConditions(doc), nil
+}
+
+// Boxscore betting line, HasSpread and HasTotal are false when the boxscore doesn't list that entry
+type GameLine struct {
+	Spread    float64 `json:"spread"`
+	OverUnder float64 `json:"overUnder"`
+	HasSpread bool    `json:"hasSpread"`
+	HasTotal  bool    `json:"hasTotal"`
+}
+
+/*
+Gets the closing line for a game from its boxscore, spread is from team's point of view (negative when favored)
+teamName is the full name PFR uses in the Vegas Line entry ("Kansas City Chiefs")
+*/
+func GetGameLine(url string, teamName string) (GameLine, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return GameLine{}, err
+	}
+
+	return parseGameLine(readGameInfo(doc), teamName), nil
+}
+
+func parseGameConditions(doc *goquery.Document) GameConditions {
+	gameInfo := readGameInfo(doc)
+
+	conditions := GameConditions{
+		Roof:    gameInfo["Roof"],
+		Surface: gameInfo["Surface"],
+		Weather: parseWeather(gameInfo["Weather"]),
+	}
+
+	// Stadium sits in the scorebox meta as "Stadium: <a href=/stadiums/KAN00.htm>"
+	doc.Find(".scorebox_meta div").Each(func(i int, div *goquery.Selection) {
+		if strings.HasPrefix(strings.TrimSpace(div.Text()), "Stadium") {
+			stadium := div.Find("a").First()
+			conditions.Stadium = strings.TrimSpace(stadium.Text())
+			conditions.StadiumId = idFromHref(stadium.AttrOr("href", ""))
+		}
+	})
+
+	return conditions
+}
+
+// Reads the boxscore game info table (Roof, Surface, Weather, Vegas Line, etc.) into label -> value
+func readGameInfo(doc *goquery.Document) map[string]string {
+	gameInfo := map[string]string{}
+	for _, row := range readTableRows(findTable(doc, "game_info")) {
+		if label := row["info"]; label != "" {
+			gameInfo[label] = row["stat"]
+		}
+	}
+	return gameInfo
+}
+
+// "41 degrees, relative humidity 63%, wind 10 mph, wind chill 33", nil when empty
+func parseWeather(s string) *Weather {
+	if s == "" {
+		return nil
+	}
+
+	weather := Weather{Description: s}
+	if match := temperatureRegex.FindStringSubmatch(s); match != nil {
+		weather.Temperature, _ = strconv.Atoi(match[1])
+	}
+	if match := humidityRegex.FindStringSubmatch(s); match != nil {
+		weather.Humidity, _ = strconv.Atoi(match[1])
+	}
+	if match := windRegex.FindStringSubmatch(s); match != nil {
+		weather.WindSpeed, _ = strconv.Atoi(match[1])
+	}
+	if match := windChillRegex.FindStringSubmatch(s); match != nil {
+		weather.WindChill, _ = strconv.Atoi(match[1])
+	}
+
+	return &weather
+}
+
+// "Kansas City Chiefs -3.5" and "47.5 (over)" -> spread for teamName and total, "Pick" is a 0 spread
+func parseGameLine(gameInfo map[string]string, teamName string) GameLine {
+	vegasLine := gameInfo["Vegas Line"]
+	total := strings.Fields(gameInfo["Over/Under"])
+
+	line := GameLine{HasSpread: vegasLine != "", HasTotal: len(total) > 0}
+	if cut := strings.LastIndex(vegasLine, " "); cut > 0 && vegasLine != "Pick" {
+		line.Spread, _ = strconv.ParseFloat(vegasLine[cut+1:], 64)
+		if !strings.EqualFold(vegasLine[:cut], teamName) {
+			line.Spread = -line.Spread
+		}
+	}
+	if line.HasTotal {
+		line.OverUnder, _ = strconv.ParseFloat(total[0], 64)
+	}
+
+	return line
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type Championship struct {
+	Year         int    `json:"year"`
+	League       string `json:"league"`
+	Game         string `json:"game"`
+	Date         string `json:"date"`
+	Winner       string `json:"winner"`
+	WinnerName   string `json:"winnerName"`
+	Loser        string `json:"loser"`
+	LoserName    string `json:"loserName"`
+	WinnerPoints int    `json:"winnerPoints"`
+	LoserPoints  int    `json:"loserPoints"`
+	MVP          string `json:"mvp,omitempty"`
+	MVPPlayerId  string `json:"mvpPlayerId,omitempty"`
+	Venue        string `json:"venue"`
+	City         string `json:"city"`
+	BoxscoreId   string `json:"boxscoreId"`
+}
+
+// Championship pages only change once a season, so each page is fetched once per server run
+var (
+	championshipCache     = map[string][]Championship{}
+	championshipCacheLock sync.Mutex
+)
+
+/*
+Gets every championship game listed on a PFR championship page (Super Bowl, pre-merger NFL or AFL title games)
+League labels each result, PFR lists the games newest first. Games are cached per page after the first successful fetch
+*/
+func GetChampionships(url string, league string) ([]Championship, error) {
+	championshipCacheLock.Lock()
+	cached, found := championshipCache[url]
+	championshipCacheLock.Unlock()
+
+	// The lock isn't held while fetching so a slow or rate limited page doesn't hold up other requests
+	if !found {
+		doc, err := fetchDoc(url)
+		if err != nil {
+			return []Championship{}, err
+		}
+
+		cached = parseChampionships(doc)
+		if len(cached) == 0 {
+			return []Championship{}, fmt.Errorf("no championship games found")
+		}
+
+		championshipCacheLock.Lock()
+		championshipCache[url] = cached
+		championshipCacheLock.Unlock()
+	}
+
+	// Cached games carry no league, one page can be requested under different labels
+	championships := make([]Championship, len(cached))
+	for i, championship := range cached {
+		championship.League = league
+		championships[i] = championship
+	}
+
+	return championships, nil
+}
+
+// Reads a championship page's games table, League is left for GetChampionships to set
+func parseChampionships(doc *goquery.Document) []Championship {
+	// Championship pages hold a single games table, its id varies by page
+	tableId, _ := doc.Find("table[id]").First().Attr("id")
+
+	championships := []Championship{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		winner := teamFromHref(row["winner_href"])
+		loser := teamFromHref(row["loser_href"])
+		if winner == "" || loser == "" {
+			continue
+		}
+
+		date := row.first("game_date", "date_game")
+		championship := Championship{
+			Year:         seasonOfGame(date),
+			Game:         row.first("superbowl", "game", "boxscore_word"),
+			Date:         date,
+			Winner:       winner,
+			WinnerName:   row.name("winner"),
+			Loser:        loser,
+			LoserName:    row.name("loser"),
+			WinnerPoints: row.intStat("pts_win"),
+			LoserPoints:  row.intStat("pts_lose"),
+			MVP:          row.name("mvp"),
+			Venue:        row["stadium"],
+			City:         strings.Trim(row["city"]+", "+row["state"], ", "),
+			BoxscoreId:   idFromHref(row.first("superbowl_href", "game_href", "boxscore_word_href")),
+		}
+		if strings.Contains(row["mvp_href"], "/players/") {
+			championship.MVPPlayerId = idFromHref(row["mvp_href"])
+		}
+
+		championships = append(championships, championship)
+	}
+
+	return championships
+}
+
+// Title games played in January or February belong to the previous season, "Feb 11, 2024" -> 2023
+func seasonOfGame(date string) int {
+	fields := strings.Fields(date)
+	if len(fields) == 0 {
+		return 0
+	}
+
+	year, _ := strconv.Atoi(fields[len(fields)-1])
+	if strings.HasPrefix(date, "Jan") || strings.HasPrefix(date, "Feb") {
+		year--
+	}
+	return year
+}
+
+/*
+Reports whether team won the title for the season, covered is false when titleGames has no game for the
+season (AAFC, NFL titles decided by standings before 1933, or a league missing from titleGames)
+*/
+func wonChampionship(titleGames []Championship, team string, year int) (won bool, covered bool) {
+	for _, championship := range titleGames {
+		if championship.Year != year {
+			continue
+		}
+		covered = true
+		if strings.EqualFold(championship.Winner, team) {
+			won = true
+		}
+	}
+	return won, covered
+}
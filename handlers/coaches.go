@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type CoachRef struct {
+	CoachId string `json:"coachId"`
+	Name    string `json:"name"`
+}
+
+type CoachSeason struct {
+	Year          int      `json:"year"`
+	Team          string   `json:"team"`
+	Coach         CoachRef `json:"coach"`
+	Games         int      `json:"games"`
+	Wins          int      `json:"wins"`
+	Losses        int      `json:"losses"`
+	Ties          int      `json:"ties"`
+	PlayoffWins   int      `json:"playoffWins"`
+	PlayoffLosses int      `json:"playoffLosses"`
+	SharedRecord  bool     `json:"sharedRecord,omitempty"` // record is the team's full season, split between the coaches listed for it
+}
+
+type CoordinatorSeason struct {
+	Year      int      `json:"year"`
+	Team      string   `json:"team"`
+	Offensive CoachRef `json:"offensive"`
+	Defensive CoachRef `json:"defensive"`
+}
+
+type Coach struct {
+	CoachId       string              `json:"coachId"`
+	Name          string              `json:"name"`
+	Teams         []string            `json:"teams"`
+	Wins          int                 `json:"wins"`
+	Losses        int                 `json:"losses"`
+	Ties          int                 `json:"ties"`
+	PlayoffWins   int                 `json:"playoffWins"`
+	PlayoffLosses int                 `json:"playoffLosses"`
+	Seasons       []CoachSeason       `json:"seasons"`
+	Coordinators  []CoordinatorSeason `json:"coordinators"`
+}
+
+// Reads coach links out of a cell, "Smith, Jones" mid-season changes return both coaches in order
+func parseCoachRefs(cell *goquery.Selection) []CoachRef {
+	coaches := []CoachRef{}
+	cell.Find("a").Each(func(i int, link *goquery.Selection) {
+		href, _ := link.Attr("href")
+		if strings.Contains(href, "/coaches/") {
+			coaches = append(coaches, CoachRef{idFromHref(href), strings.TrimSpace(link.Text())})
+		}
+	})
+
+	// Fall back to plain names when the cell has no links
+	if len(coaches) == 0 {
+		for _, name := range strings.Split(cell.Text(), ",") {
+			if strings.TrimSpace(name) != "" {
+				coaches = append(coaches, CoachRef{"", strings.TrimSpace(name)})
+			}
+		}
+	}
+
+	return coaches
+}
+
+func GetCoach(url string, coachId string) (Coach, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return Coach{}, err
+	}
+
+	coach := Coach{
+		CoachId:      coachId,
+		Name:         strings.TrimSpace(doc.Find("#meta h1").First().Text()),
+		Teams:        []string{},
+		Seasons:      []CoachSeason{},
+		Coordinators: []CoordinatorSeason{},
+	}
+
+	seenTeams := map[string]bool{}
+	for _, row := range readTableRows(findTable(doc, "coaching_results")) {
+		year := row.intStat("year_id")
+		if year == 0 {
+			continue
+		}
+
+		team := row.first("team", "team_name_abbr")
+		if teamHref := row.first("team_href", "team_name_abbr_href"); teamHref != "" {
+			team = teamFromHref(teamHref)
+		}
+
+		season := CoachSeason{
+			Year:          year,
+			Team:          team,
+			Coach:         CoachRef{coachId, coach.Name},
+			Games:         row.intStat("g"),
+			Wins:          row.intStat("wins"),
+			Losses:        row.intStat("losses"),
+			Ties:          row.intStat("ties"),
+			PlayoffWins:   row.intStat("wins_playoffs", "g_playoffs_won"),
+			PlayoffLosses: row.intStat("losses_playoffs", "g_playoffs_lost"),
+		}
+
+		coach.Wins += season.Wins
+		coach.Losses += season.Losses
+		coach.Ties += season.Ties
+		coach.PlayoffWins += season.PlayoffWins
+		coach.PlayoffLosses += season.PlayoffLosses
+		coach.Seasons = append(coach.Seasons, season)
+
+		if !seenTeams[team] {
+			seenTeams[team] = true
+			coach.Teams = append(coach.Teams, team)
+		}
+	}
+
+	if len(coach.Seasons) == 0 {
+		return Coach{}, fmt.Errorf("no head coaching record found for %s", coachId)
+	}
+
+	// Coordinators sit in the coaching ranks table, read from the cells so linked coach ids are kept
+	findTable(doc, "coaching_ranks").Find("tbody tr").Each(func(i int, row *goquery.Selection) {
+		year, err := strconv.Atoi(strings.TrimSpace(row.Find("[data-stat=year_id]").Text()))
+		if err != nil {
+			return
+		}
+
+		coordinators := CoordinatorSeason{
+			Year: year,
+			Team: teamFromHref(row.Find("[data-stat=team] a, [data-stat=team_name_abbr] a").AttrOr("href", "")),
+		}
+		if offensive := parseCoachRefs(row.Find("[data-stat=off_coordinator], [data-stat=coord_off]")); len(offensive) > 0 {
+			coordinators.Offensive = offensive[0]
+		}
+		if defensive := parseCoachRefs(row.Find("[data-stat=def_coordinator], [data-stat=coord_def]")); len(defensive) > 0 {
+			coordinators.Defensive = defensive[0]
+		}
+
+		coach.Coordinators = append(coach.Coordinators, coordinators)
+	})
+
+	return coach, nil
+}
+
+func GetTeamCoaches(url string, tableId string, team string) ([]CoachSeason, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []CoachSeason{}, err
+	}
+
+	seasons := []CoachSeason{}
+	findTable(doc, tableId).Find("tbody tr").Each(func(i int, row *goquery.Selection) {
+		year, err := strconv.Atoi(strings.TrimSpace(row.Find("[data-stat=year_id]").Text()))
+		if err != nil {
+			return
+		}
+
+		stat := func(key string) int {
+			value, _ := strconv.Atoi(strings.TrimSpace(row.Find("[data-stat=" + key + "]").Text()))
+			return value
+		}
+
+		// One entry per coach, seasons with a mid-season change repeat the year. PFR usually gives each coach
+		// their own row, when a row lists several coaches its record can't be split between them
+		coaches := parseCoachRefs(row.Find("[data-stat=coach], [data-stat=coaches]"))
+		for _, coach := range coaches {
+			season := CoachSeason{
+				Year:          year,
+				Team:          team,
+				Coach:         coach,
+				Games:         stat("g"),
+				Wins:          stat("wins"),
+				Losses:        stat("losses"),
+				Ties:          stat("ties"),
+				PlayoffWins:   stat("wins_playoffs"),
+				PlayoffLosses: stat("losses_playoffs"),
+				SharedRecord:  len(coaches) > 1,
+			}
+
+			seasons = append(seasons, season)
+		}
+	})
+
+	if len(seasons) == 0 {
+		return []CoachSeason{}, fmt.Errorf("no coaches found for %s", team)
+	}
+
+	return seasons, nil
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type CombineResult struct {
+	Year         int     `json:"year"`
+	PlayerId     string  `json:"playerId"`
+	Name         string  `json:"name"`
+	Position     string  `json:"position"`
+	School       string  `json:"school"`
+	Height       string  `json:"height"`
+	HeightInches int     `json:"heightInches"`
+	Weight       int     `json:"weight"`
+	FortyYard    float64 `json:"fortyYard"`
+	Vertical     float64 `json:"vertical"`
+	BenchReps    int     `json:"benchReps"`
+	BroadJump    int     `json:"broadJump"`
+	ThreeCone    float64 `json:"threeCone"`
+	Shuttle      float64 `json:"shuttle"`
+	Drafted      bool    `json:"drafted"`
+	DraftTeam    string  `json:"draftTeam"`
+	DraftRound   int     `json:"draftRound"`
+	DraftPick    int     `json:"draftPick"`
+}
+
+// Optional filters for combine queries, Metric is one of the keys in combineMetrics
+type CombineFilter struct {
+	Position string
+	Metric   string
+	Min      float64
+	Max      float64
+}
+
+var combineMetrics = map[string]func(CombineResult) float64{
+	"forty":    func(r CombineResult) float64 { return r.FortyYard },
+	"vertical": func(r CombineResult) float64 { return r.Vertical },
+	"bench":    func(r CombineResult) float64 { return float64(r.BenchReps) },
+	"broad":    func(r CombineResult) float64 { return float64(r.BroadJump) },
+	"cone":     func(r CombineResult) float64 { return r.ThreeCone },
+	"shuttle":  func(r CombineResult) float64 { return r.Shuttle },
+	"weight":   func(r CombineResult) float64 { return float64(r.Weight) },
+	"height":   func(r CombineResult) float64 { return float64(r.HeightInches) },
+}
+
+func (f CombineFilter) matches(result CombineResult) bool {
+	if f.Position != "" && !strings.EqualFold(result.Position, f.Position) {
+		return false
+	}
+	if f.Metric == "" {
+		return true
+	}
+
+	// Players who skipped the drill are left out of metric filtered results
+	value := combineMetrics[f.Metric](result)
+	if value == 0 {
+		return false
+	}
+	if f.Min != 0 && value < f.Min {
+		return false
+	}
+	if f.Max != 0 && value > f.Max {
+		return false
+	}
+	return true
+}
+
+func GetCombineResults(url string, tableId string, year int, filter CombineFilter) ([]CombineResult, error) {
+	if _, exists := combineMetrics[filter.Metric]; filter.Metric != "" && !exists {
+		return []CombineResult{}, fmt.Errorf("unknown metric %s", filter.Metric)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []CombineResult{}, err
+	}
+
+	results := []CombineResult{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		if row["player"] == "" {
+			continue
+		}
+
+		playerId := row["player_id"]
+		if playerId == "" {
+			playerId = idFromHref(row["player_href"])
+		}
+
+		// "6-2" -> 74
+		var heightInches int
+		height := row["height"]
+		if feet, inches, found := strings.Cut(height, "-"); found {
+			feetInt, _ := strconv.Atoi(feet)
+			inchesInt, _ := strconv.Atoi(inches)
+			heightInches = feetInt*12 + inchesInt
+		}
+
+		_, draftRound, draftPick, _ := parseDraftInfo(row["draft_info"])
+
+		result := CombineResult{
+			Year:         year,
+			PlayerId:     playerId,
+			Name:         row.name("player"),
+			Position:     row["pos"],
+			School:       row.first("school_name", "college"),
+			Height:       height,
+			HeightInches: heightInches,
+			Weight:       row.intStat("weight"),
+			FortyYard:    row.floatStat("forty_yd"),
+			Vertical:     row.floatStat("vertical"),
+			BenchReps:    row.intStat("bench_reps"),
+			BroadJump:    row.intStat("broad_jump"),
+			ThreeCone:    row.floatStat("cone"),
+			Shuttle:      row.floatStat("shuttle"),
+			Drafted:      row["draft_info"] != "",
+			DraftTeam:    teamFromHref(row["draft_info_href"]),
+			DraftRound:   draftRound,
+			DraftPick:    draftPick,
+		}
+
+		if filter.matches(result) {
+			results = append(results, result)
+		}
+	}
+
+	if len(results) == 0 {
+		return []CombineResult{}, fmt.Errorf("no combine results found for year %d", year)
+	}
+
+	return results, nil
+}
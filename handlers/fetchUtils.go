@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Fetches and parses a PFR page, a rate limited (429) request is retried once after PFR's Retry-After wait
+func fetchDoc(url string) (*goquery.Document, error) {
+	// ---- CLIENT BOILERPLATE ----
+	client := &http.Client{
+		Timeout: 4 * (time.Second + 8),
+	}
+
+	maxRetries := 2
+	var resp *http.Response
+	var err error
+
+	for attempt := 1; attempt <= maxRetries; attempt++ {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request: %v", err)
+		}
+
+		// Headers
+		req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
+		req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,image/webp,*/*;q=0.8")
+		req.Header.Set("Accept-Language", "en-US,en;q=0.5")
+
+		resp, err = client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error making request: %v", err)
+		}
+
+		// Rate limit check
+		if resp.StatusCode == 429 {
+			resp.Body.Close()
+			if attempt == maxRetries {
+				return nil, fmt.Errorf("hit rate limit after %d attempts", maxRetries)
+			}
+
+			retryAfter := resp.Header.Get("Retry-After")
+			waitTime := 15 * time.Second
+			if retryAfter != "" {
+				if seconds, err := strconv.Atoi(retryAfter); err == nil {
+					waitTime = time.Duration(seconds) * time.Second
+				}
+			}
+
+			log.Printf("Rate limited. Waiting %v before retry %d/%d", waitTime, attempt, maxRetries)
+			time.Sleep(waitTime)
+			continue
+		}
+
+		// Successful response
+		if resp.StatusCode == 200 {
+			break
+		}
+
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing HTML: %v", err)
+	}
+
+	// ---- END ----
+
+	return doc, nil
+}
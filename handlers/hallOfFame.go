@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+type HallOfFamer struct {
+	Name          string   `json:"name"`
+	PlayerId      string   `json:"playerId,omitempty"`
+	CoachId       string   `json:"coachId,omitempty"`
+	ContributorId string   `json:"contributorId,omitempty"`
+	Position      string   `json:"position"`
+	InductionYear int      `json:"inductionYear"`
+	FirstSeason   int      `json:"firstSeason"`
+	LastSeason    int      `json:"lastSeason"`
+	Teams         []string `json:"teams"`
+}
+
+// Optional filters for Hall of Fame inductees, zero values match everything
+type HallOfFameFilter struct {
+	Year     int
+	Name     string
+	Position string
+	Team     string
+	From     int
+	To       int
+}
+
+func (f HallOfFameFilter) matches(inductee HallOfFamer) bool {
+	if f.Year != 0 && inductee.InductionYear != f.Year {
+		return false
+	}
+	if f.From != 0 && inductee.InductionYear < f.From {
+		return false
+	}
+	if f.To != 0 && inductee.InductionYear > f.To {
+		return false
+	}
+	if f.Name != "" && !strings.Contains(strings.ToLower(inductee.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.Position != "" && !strings.EqualFold(inductee.Position, f.Position) {
+		return false
+	}
+	if f.Team != "" {
+		for _, team := range inductee.Teams {
+			if strings.EqualFold(team, f.Team) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+/*
+Gets Hall of Fame inductees matching filter
+PlayerId matches DraftPick.PlayerId, so inductees can be joined with draft results (see DraftPick.HallOfFame)
+*/
+func GetHallOfFame(url string, tableId string, filter HallOfFameFilter) ([]HallOfFamer, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []HallOfFamer{}, err
+	}
+
+	inductees := []HallOfFamer{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		name := row["player"]
+		if name == "" {
+			continue
+		}
+
+		inductee := HallOfFamer{
+			Name:          cleanName(name),
+			Position:      row["pos"],
+			InductionYear: row.intStat("year_induction"),
+			FirstSeason:   row.intStat("year_min"),
+			LastSeason:    row.intStat("year_max"),
+			Teams:         []string{},
+		}
+
+		// Players, coaches and contributors link to different PFR sections
+		href := row["player_href"]
+		switch {
+		case strings.Contains(href, "/players/"):
+			inductee.PlayerId = idFromHref(href)
+		case strings.Contains(href, "/coaches/"):
+			inductee.CoachId = idFromHref(href)
+		case href != "":
+			inductee.ContributorId = idFromHref(href)
+		}
+
+		for _, teamHref := range strings.Fields(row.first("teams_hrefs", "teams_href")) {
+			if team := teamFromHref(teamHref); team != "" {
+				inductee.Teams = append(inductee.Teams, team)
+			}
+		}
+
+		if filter.matches(inductee) {
+			inductees = append(inductees, inductee)
+		}
+	}
+
+	if len(inductees) == 0 {
+		return []HallOfFamer{}, fmt.Errorf("no Hall of Fame inductees found for selected filters")
+	}
+
+	return inductees, nil
+}
@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Meeting struct {
+	Year          int    `json:"year"`
+	Date          string `json:"date"`
+	Week          string `json:"week"`
+	Playoffs      bool   `json:"playoffs"`
+	TeamName      string `json:"teamName"`
+	OpponentName  string `json:"opponentName"`
+	HomeTeam      string `json:"homeTeam"`
+	Neutral       bool   `json:"neutral"`
+	Result        string `json:"result"` // W, L or T for team
+	PointsFor     int    `json:"pointsFor"`
+	PointsAgainst int    `json:"pointsAgainst"`
+	Margin        int    `json:"margin"`
+	BoxscoreId    string `json:"boxscoreId"`
+}
+
+type SeriesRecord struct {
+	Wins          int `json:"wins"`
+	Losses        int `json:"losses"`
+	Ties          int `json:"ties"`
+	PointsFor     int `json:"pointsFor"`
+	PointsAgainst int `json:"pointsAgainst"`
+}
+
+type SeriesStreak struct {
+	Team      string `json:"team"`
+	Games     int    `json:"games"`
+	StartYear int    `json:"startYear"`
+	EndYear   int    `json:"endYear"`
+}
+
+type HeadToHead struct {
+	Team              string       `json:"team"`
+	TeamName          string       `json:"teamName"`
+	Opponent          string       `json:"opponent"`
+	OpponentName      string       `json:"opponentName"`
+	Overall           SeriesRecord `json:"overall"`
+	RegularSeason     SeriesRecord `json:"regularSeason"`
+	Playoffs          SeriesRecord `json:"playoffs"`
+	CurrentStreak     SeriesStreak `json:"currentStreak"`
+	LongestTeamStreak SeriesStreak `json:"longestTeamStreak"`
+	LongestOppStreak  SeriesStreak `json:"longestOppStreak"`
+	LargestWin        *Meeting     `json:"largestWin"`
+	LargestLoss       *Meeting     `json:"largestLoss"`
+	Meetings          []Meeting    `json:"meetings"`
+}
+
+/*
+Gets the all-time series between two franchises, results are from team's point of view
+Team names are resolved per season from the franchise registry, so relocated franchises keep one history (Houston Oilers -> Tennessee Titans)
+*/
+func GetHeadToHead(url string, tableId string, team string, opponent string) (HeadToHead, error) {
+	teamFranchise, exists := GetFranchise(team)
+	if !exists {
+		return HeadToHead{}, fmt.Errorf("unknown team %s", team)
+	}
+	oppFranchise, exists := GetFranchise(opponent)
+	if !exists {
+		return HeadToHead{}, fmt.Errorf("unknown team %s", opponent)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return HeadToHead{}, err
+	}
+
+	meetings := []Meeting{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		boxscoreId := idFromHref(row.first("boxscore_word_href", "game_date_href"))
+		if boxscoreId == "" {
+			continue
+		}
+
+		year := row.intStat("year_id")
+		week := row.first("week_num", "game_type")
+		_, weekErr := strconv.Atoi(week)
+		pointsFor := row.intStat("pts_off")
+		pointsAgainst := row.intStat("pts_def")
+
+		// "@" marks team as the road team, "N" a neutral site
+		homeTeam := team
+		if row["game_location"] == "@" {
+			homeTeam = opponent
+		}
+
+		result := "T"
+		if pointsFor > pointsAgainst {
+			result = "W"
+		} else if pointsFor < pointsAgainst {
+			result = "L"
+		}
+
+		meetings = append(meetings, Meeting{
+			Year:          year,
+			Date:          row.first("game_date", "date_game"),
+			Week:          week,
+			Playoffs:      week != "" && weekErr != nil && !strings.EqualFold(week, "reg"),
+			TeamName:      teamFranchise.NameInYear(year),
+			OpponentName:  oppFranchise.NameInYear(year),
+			HomeTeam:      homeTeam,
+			Neutral:       row["game_location"] == "N",
+			Result:        result,
+			PointsFor:     pointsFor,
+			PointsAgainst: pointsAgainst,
+			Margin:        pointsFor - pointsAgainst,
+			BoxscoreId:    boxscoreId,
+		})
+	}
+
+	if len(meetings) == 0 {
+		return HeadToHead{}, fmt.Errorf("no meetings found between %s and %s", team, opponent)
+	}
+
+	// Boxscore ids start with the game date (199410020oti), so they order meetings chronologically
+	sort.SliceStable(meetings, func(i, j int) bool {
+		return meetings[i].BoxscoreId < meetings[j].BoxscoreId
+	})
+
+	headToHead := HeadToHead{
+		Team:         team,
+		TeamName:     teamFranchise.Name,
+		Opponent:     opponent,
+		OpponentName: oppFranchise.Name,
+		Meetings:     meetings,
+	}
+
+	var streak SeriesStreak
+	for i := range meetings {
+		meeting := &meetings[i]
+
+		headToHead.Overall.add(*meeting)
+		if meeting.Playoffs {
+			headToHead.Playoffs.add(*meeting)
+		} else {
+			headToHead.RegularSeason.add(*meeting)
+		}
+
+		if headToHead.LargestWin == nil || meeting.Margin > headToHead.LargestWin.Margin {
+			headToHead.LargestWin = meeting
+		}
+		if headToHead.LargestLoss == nil || meeting.Margin < headToHead.LargestLoss.Margin {
+			headToHead.LargestLoss = meeting
+		}
+
+		// Ties end any streak
+		winner := ""
+		switch meeting.Result {
+		case "W":
+			winner = team
+		case "L":
+			winner = opponent
+		}
+
+		if winner != "" && winner == streak.Team {
+			streak.Games++
+			streak.EndYear = meeting.Year
+		} else {
+			streak = SeriesStreak{Team: winner, Games: 1, StartYear: meeting.Year, EndYear: meeting.Year}
+		}
+		if winner == "" {
+			streak.Games = 0
+		}
+
+		if winner == team && streak.Games > headToHead.LongestTeamStreak.Games {
+			headToHead.LongestTeamStreak = streak
+		}
+		if winner == opponent && streak.Games > headToHead.LongestOppStreak.Games {
+			headToHead.LongestOppStreak = streak
+		}
+	}
+	headToHead.CurrentStreak = streak
+
+	// Only report a largest win or loss that actually went that way
+	if headToHead.LargestWin.Margin <= 0 {
+		headToHead.LargestWin = nil
+	}
+	if headToHead.LargestLoss.Margin >= 0 {
+		headToHead.LargestLoss = nil
+	}
+
+	return headToHead, nil
+}
+
+func (r *SeriesRecord) add(meeting Meeting) {
+	switch meeting.Result {
+	case "W":
+		r.Wins++
+	case "L":
+		r.Losses++
+	default:
+		r.Ties++
+	}
+	r.PointsFor += meeting.PointsFor
+	r.PointsAgainst += meeting.PointsAgainst
+}
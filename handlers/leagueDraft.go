@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Optional filters for draft queries, zero values match every pick
+type DraftFilter struct {
+	Round    int
+	Position string
+	Team     string
+	College  string
+}
+
+func (f DraftFilter) matches(pick DraftPick) bool {
+	if f.Round != 0 && pick.Round != f.Round {
+		return false
+	}
+	if f.Position != "" && !strings.EqualFold(pick.Position, f.Position) {
+		return false
+	}
+	if f.Team != "" && !strings.EqualFold(pick.Team, f.Team) {
+		return false
+	}
+	if f.College != "" && !strings.Contains(strings.ToLower(pick.College), strings.ToLower(f.College)) {
+		return false
+	}
+	return true
+}
+
+func GetLeagueDraft(url string, tableId string, year int, filter DraftFilter) ([]DraftPick, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []DraftPick{}, err
+	}
+
+	resDraft := []DraftPick{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		// Skip round header rows
+		if row.first("player", "player_name") == "" {
+			continue
+		}
+
+		team := row.first("team", "team_name_abbr")
+		if teamHref := row.first("team_href", "team_name_abbr_href"); teamHref != "" {
+			team = teamFromHref(teamHref)
+		}
+
+		playerId := row.first("player_id", "player_name_id")
+		if playerId == "" {
+			playerId = idFromHref(row.first("player_href", "player_name_href"))
+		}
+
+		draftPick := DraftPick{
+			Year:          year,
+			Round:         row.intStat("draft_round"),
+			Name:          cleanName(strings.TrimSuffix(row.first("player", "player_name"), "HOF")),
+			PlayerId:      playerId,
+			HallOfFame:    strings.HasSuffix(row.first("player", "player_name"), "HOF"),
+			Team:          team,
+			Pick:          row.intStat("draft_pick"),
+			Position:      row["pos"],
+			Age:           row.intStat("age"),
+			LastSeason:    row.intStat("year_max"),
+			FirstAllPro:   row.intStat("all_pros_first_team"),
+			ProBowl:       row.intStat("pro_bowls"),
+			StarterYears:  row.intStat("years_as_primary_starter"),
+			CareerAV:      row.intStat("career_av"),
+			GamesPlayed:   row.intStat("g"),
+			PassCmp:       row.intStat("pass_cmp"),
+			PassAtt:       row.intStat("pass_att"),
+			PassYds:       row.intStat("pass_yds"),
+			PassTDs:       row.intStat("pass_td"),
+			PassInts:      row.intStat("pass_int"),
+			RushAtt:       row.intStat("rush_att"),
+			RushYds:       row.intStat("rush_yds"),
+			RushTDs:       row.intStat("rush_td"),
+			ReceivingRecs: row.intStat("rec"),
+			ReceivingYds:  row.intStat("rec_yds"),
+			ReceivingTDs:  row.intStat("rec_td"),
+			DefInts:       row.intStat("def_int"),
+			DefSacks:      int(row.floatStat("sacks")),
+			College:       row.first("college_id", "college"),
+		}
+
+		if filter.matches(draftPick) {
+			resDraft = append(resDraft, draftPick)
+		}
+	}
+
+	if len(resDraft) == 0 {
+		return []DraftPick{}, fmt.Errorf("no picks found for year %d", year)
+	}
+
+	return resDraft, nil
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type GameLogEntry struct {
+	Date                 string             `json:"date"`
+	GameNum              int                `json:"gameNum"`
+	Week                 int                `json:"week"`
+	Age                  string             `json:"age"`
+	Team                 string             `json:"team"`
+	Away                 bool               `json:"away"`
+	Opponent             string             `json:"opponent"`
+	Result               string             `json:"result"`
+	Started              bool               `json:"started"`
+	OffenseSnaps         int                `json:"offenseSnaps"`
+	OffenseSnapPerc      float64            `json:"offenseSnapPerc"`
+	DefenseSnaps         int                `json:"defenseSnaps"`
+	DefenseSnapPerc      float64            `json:"defenseSnapPerc"`
+	SpecialTeamsSnaps    int                `json:"specialTeamsSnaps"`
+	SpecialTeamsSnapPerc float64            `json:"specialTeamsSnapPerc"`
+	Reason               string             `json:"reason,omitempty"` // why the player has no stat line (Inactive, Did Not Play, etc.)
+	Stats                map[string]float64 `json:"stats"`
+}
+
+type GameLog struct {
+	PlayerId      string         `json:"playerId"`
+	Year          int            `json:"year"`
+	RegularSeason []GameLogEntry `json:"regularSeason"`
+	Playoffs      []GameLogEntry `json:"playoffs"`
+}
+
+// Columns describing the game itself, everything else in a row is part of the stat line
+var gameLogInfoStats = map[string]bool{
+	"ranker": true, "year_id": true, "game_date": true, "date": true, "game_num": true, "team_game_num_season": true,
+	"week_num": true, "age": true, "team": true, "team_name_abbr": true, "game_location": true, "opp": true,
+	"opp_name_abbr": true, "game_result": true, "gs": true, "offense": true, "off_pct": true, "defense": true,
+	"def_pct": true, "special_teams": true, "st_pct": true, "snap_counts_offense": true, "snap_counts_off_pct": true,
+	"snap_counts_defense": true, "snap_counts_def_pct": true, "snap_counts_special_teams": true, "snap_counts_st_pct": true,
+}
+
+func GetPlayerGameLog(url string, playerId string, year int) (GameLog, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return GameLog{}, err
+	}
+
+	gameLog := GameLog{
+		PlayerId:      playerId,
+		Year:          year,
+		RegularSeason: parseGameLogRows(readTableRows(findTable(doc, "stats"))),
+		Playoffs:      parseGameLogRows(readTableRows(findTable(doc, "stats_playoffs"))),
+	}
+
+	if len(gameLog.RegularSeason) == 0 && len(gameLog.Playoffs) == 0 {
+		return GameLog{}, fmt.Errorf("no games found for %s in %d", playerId, year)
+	}
+
+	return gameLog, nil
+}
+
+func parseGameLogRows(rows []tableRow) []GameLogEntry {
+	games := []GameLogEntry{}
+
+	for _, row := range rows {
+		date := row.first("game_date", "date")
+		// Only header and spacer rows lack a date. Did Not Play, Inactive and suspended games keep
+		// their row with the reason in place of a stat line
+		if date == "" {
+			continue
+		}
+
+		gameNum, _ := strconv.Atoi(row.first("game_num", "team_game_num_season"))
+		week, _ := strconv.Atoi(row["week_num"])
+		offenseSnaps, _ := strconv.Atoi(row.first("offense", "snap_counts_offense"))
+		offenseSnapPerc, _ := strconv.ParseFloat(strings.TrimSuffix(row.first("off_pct", "snap_counts_off_pct"), "%"), 64)
+		defenseSnaps, _ := strconv.Atoi(row.first("defense", "snap_counts_defense"))
+		defenseSnapPerc, _ := strconv.ParseFloat(strings.TrimSuffix(row.first("def_pct", "snap_counts_def_pct"), "%"), 64)
+		specialTeamsSnaps, _ := strconv.Atoi(row.first("special_teams", "snap_counts_special_teams"))
+		specialTeamsSnapPerc, _ := strconv.ParseFloat(strings.TrimSuffix(row.first("st_pct", "snap_counts_st_pct"), "%"), 64)
+
+		stats := map[string]float64{}
+		for stat, value := range row {
+			if gameLogInfoStats[stat] || strings.HasSuffix(stat, "_id") || strings.HasSuffix(stat, "_href") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+			if err == nil {
+				stats[stat] = parsed
+			}
+		}
+
+		game := GameLogEntry{
+			Date:                 date,
+			GameNum:              gameNum,
+			Week:                 week,
+			Age:                  row["age"],
+			Team:                 row.first("team", "team_name_abbr"),
+			Away:                 row["game_location"] == "@",
+			Opponent:             row.first("opp", "opp_name_abbr"),
+			Result:               row["game_result"],
+			Started:              row["gs"] == "*",
+			OffenseSnaps:         offenseSnaps,
+			OffenseSnapPerc:      offenseSnapPerc / 100,
+			DefenseSnaps:         defenseSnaps,
+			DefenseSnapPerc:      defenseSnapPerc / 100,
+			SpecialTeamsSnaps:    specialTeamsSnaps,
+			SpecialTeamsSnapPerc: specialTeamsSnapPerc / 100,
+			Reason:               row["reason"],
+			Stats:                stats,
+		}
+
+		games = append(games, game)
+	}
+
+	return games
+}
@@ -13,8 +13,72 @@ import (
 )
 
 type AwardWinner struct {
-	Award  string `json:"award"`
-	Winner string `json:"winner"`
+	Year      int     `json:"year,omitempty"`
+	AwardId   string  `json:"awardId"`
+	Award     string  `json:"award"`
+	Winner    string  `json:"winner"`
+	PlayerId  string  `json:"playerId,omitempty"`
+	CoachId   string  `json:"coachId,omitempty"`
+	Team      string  `json:"team,omitempty"`
+	Position  string  `json:"position,omitempty"`
+	VoteShare float64 `json:"voteShare,omitempty"`
+}
+
+// Award labels on the season page -> PFR award page ids (pro-football-reference.com/awards/{id}.htm)
+var awardIds = map[string]string{
+	"AP MVP":                              "ap-nfl-mvp-award",
+	"AP Offensive Player of the Year":     "ap-offensive-player-of-the-year",
+	"AP Defensive Player of the Year":     "ap-defensive-player-of-the-year",
+	"AP Offensive Rookie of the Year":     "ap-offensive-rookie-of-the-year-award",
+	"AP Defensive Rookie of the Year":     "ap-defensive-rookie-of-the-year-award",
+	"AP Comeback Player of the Year":      "ap-comeback-player-award",
+	"AP Coach of the Year":                "ap-coach-of-the-year",
+	"Walter Payton Man of the Year":       "walter-payton-man-of-the-year",
+	"PFWA MVP":                            "pfwa-nfl-mvp-award",
+	"Pepsi Rookie of the Year":            "pepsi-rookie-of-the-year",
+	"Super Bowl MVP":                      "super-bowl-mvp",
+	"AP Assistant Coach of the Year":      "ap-assistant-coach-of-the-year",
+	"AP Executive of the Year":            "ap-executive-of-the-year",
+	"Sporting News Executive of the Year": "sporting-news-executive-of-the-year",
+}
+
+// Known award id for a label, otherwise the label as a slug ("Some Award" -> "some-award")
+func awardIdFromLabel(label string) string {
+	if id, exists := awardIds[label]; exists {
+		return id
+	}
+	return strings.ToLower(strings.Join(strings.Fields(label), "-"))
+}
+
+// Reads player, coach and team links and a "(0.98)" style vote share from a winner element
+func parseAwardWinner(label string, s *goquery.Selection) AwardWinner {
+	award := AwardWinner{
+		AwardId: awardIdFromLabel(label),
+		Award:   label,
+		Winner:  strings.TrimSpace(s.Text()),
+	}
+
+	links := s.Find("a").AddSelection(s.Filter("a"))
+	links.Each(func(i int, link *goquery.Selection) {
+		href, _ := link.Attr("href")
+		switch {
+		case strings.Contains(href, "/players/") && award.PlayerId == "":
+			award.PlayerId = idFromHref(href)
+			award.Winner = strings.TrimSpace(link.Text())
+		case strings.Contains(href, "/coaches/") && award.CoachId == "":
+			award.CoachId = idFromHref(href)
+			award.Winner = strings.TrimSpace(link.Text())
+		case strings.Contains(href, "/teams/") && award.Team == "":
+			award.Team = teamFromHref(href)
+		}
+	})
+
+	if open := strings.LastIndex(s.Text(), "("); open != -1 {
+		share := strings.TrimSuffix(strings.TrimSpace(s.Text()[open+1:]), ")")
+		award.VoteShare, _ = strconv.ParseFloat(share, 64)
+	}
+
+	return award
 }
 
 func GetSeasonAwardWinners(url string) ([]AwardWinner, error) {
@@ -87,8 +151,8 @@ func GetSeasonAwardWinners(url string) ([]AwardWinner, error) {
 		return []AwardWinner{}, fmt.Errorf("error parsing HTML: %v", err)
 	}
 
-	// Clean html, remove comment symbols and ":"
-	commentMarkersRegex := regexp.MustCompile(`<!--|-->|:`)
+	// Clean html, remove comment symbols
+	commentMarkersRegex := regexp.MustCompile(`<!--|-->`)
 	cleanHtml := commentMarkersRegex.ReplaceAllString(html, "")
 
 	// Create new doc from parsed and cleaned HTML comment
@@ -100,20 +164,28 @@ func GetSeasonAwardWinners(url string) ([]AwardWinner, error) {
 
 	// Setup result values
 	var awardWinners []AwardWinner
-	var awardWinnerHolder AwardWinner
+	var label string
 
-	// Extract awards and winners
+	// Extract awards and winners, label and winner nodes alternate with ":" separators in between
 	isCategory := true
 	divSelection.Contents().Each(func(i int, s *goquery.Selection) {
-		if strings.TrimSpace(s.Text()) != "" {
-			if isCategory {
-				awardWinnerHolder.Award = s.Text()
-			} else {
-				awardWinnerHolder.Winner = s.Text()
-				awardWinners = append(awardWinners, awardWinnerHolder)
-			}
-			isCategory = !isCategory
+		text := strings.Trim(strings.TrimSpace(s.Text()), ": ")
+		if text == "" {
+			return
+		}
+
+		// Label and winner in one element, e.g. <p><strong>AP MVP</strong>: <a>Peyton Manning</a></p>
+		if strong := s.Find("strong, b").First(); strong.Length() > 0 && s.Find("a").Length() > 0 {
+			awardWinners = append(awardWinners, parseAwardWinner(strings.Trim(strings.TrimSpace(strong.Text()), ": "), s))
+			return
+		}
+
+		if isCategory {
+			label = text
+		} else {
+			awardWinners = append(awardWinners, parseAwardWinner(label, s))
 		}
+		isCategory = !isCategory
 	})
 
 	if len(awardWinners) == 0 {
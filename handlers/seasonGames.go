@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Game struct {
+	Week            string          `json:"week"`
+	Day             string          `json:"day"`
+	Date            string          `json:"date"`
+	Time            string          `json:"time"`
+	Winner          string          `json:"winner"`
+	WinnerName      string          `json:"winnerName"`
+	Loser           string          `json:"loser"`
+	LoserName       string          `json:"loserName"`
+	HomeTeam        string          `json:"homeTeam"`
+	AwayTeam        string          `json:"awayTeam"`
+	Neutral         bool            `json:"neutral"`
+	Played          bool            `json:"played"` // false for scheduled games, which have no winner or score yet
+	Tie             bool            `json:"tie"`
+	WinnerPoints    int             `json:"winnerPoints"`
+	LoserPoints     int             `json:"loserPoints"`
+	WinnerYards     int             `json:"winnerYards"`
+	WinnerTurnovers int             `json:"winnerTurnovers"`
+	LoserYards      int             `json:"loserYards"`
+	LoserTurnovers  int             `json:"loserTurnovers"`
+	BoxscoreId      string          `json:"boxscoreId"`
+	Conditions      *GameConditions `json:"conditions,omitempty"` // only filled on request, one boxscore per game
+}
+
+/*
+Gets every game of a season from the league schedule
+Specify week as a week number (1, 2, etc.) or PFR playoff label (WildCard, Division, ConfChamp, SuperBowl), empty returns every game
+*/
+func GetSeasonGames(url string, tableId string, week string) ([]Game, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []Game{}, err
+	}
+
+	games := []Game{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		if week != "" && !strings.EqualFold(row["week_num"], week) {
+			continue
+		}
+		if game, ok := parseGameRow(row); ok {
+			games = append(games, game)
+		}
+	}
+
+	if len(games) == 0 {
+		return []Game{}, fmt.Errorf("no games found for selected week")
+	}
+
+	return games, nil
+}
+
+// Reads a row of PFR's winner/loser style schedule tables, false for header rows. Scheduled games come
+// back with Played false and only the teams, date and site set, the result fields stay empty
+func parseGameRow(row tableRow) (Game, bool) {
+	winner := teamFromHref(row.first("winner_href", "team_href"))
+	loser := teamFromHref(row.first("loser_href", "opp_href"))
+	if winner == "" || loser == "" {
+		return Game{}, false
+	}
+
+	// "@" marks the winner (or for scheduled games the first listed team) as the road team, "N" a neutral site
+	homeTeam, awayTeam := winner, loser
+	if row["game_location"] == "@" {
+		homeTeam, awayTeam = loser, winner
+	}
+
+	game := Game{
+		Week:     row["week_num"],
+		Day:      row["game_day_of_week"],
+		Date:     row["game_date"],
+		Time:     row["gametime"],
+		HomeTeam: homeTeam,
+		AwayTeam: awayTeam,
+		Neutral:  row["game_location"] == "N",
+		Played:   row["pts_win"] != "" && row["pts_lose"] != "",
+	}
+
+	// The boxscore column links a preview until the game is played
+	if !game.Played {
+		return game, true
+	}
+
+	if game.Date == "" {
+		game.Date = row["boxscore_word"]
+	}
+	game.Winner = winner
+	game.WinnerName = row.name("winner")
+	game.Loser = loser
+	game.LoserName = row.name("loser")
+	game.WinnerPoints = row.intStat("pts_win")
+	game.LoserPoints = row.intStat("pts_lose")
+	game.Tie = game.WinnerPoints == game.LoserPoints
+	game.WinnerYards = row.intStat("yards_win")
+	game.WinnerTurnovers = row.intStat("to_win")
+	game.LoserYards = row.intStat("yards_lose")
+	game.LoserTurnovers = row.intStat("to_lose")
+	game.BoxscoreId = idFromHref(row["boxscore_word_href"])
+
+	return game, true
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Optional filters and ordering for league leader tables
+type LeaderFilter struct {
+	Team        string
+	Position    string
+	MinAttempts int
+	SortBy      string // any PFR data-stat in the category (pass_yds, rush_td, sacks, etc.)
+	Ascending   bool
+}
+
+// League page category -> table id, typed row parser and stat counted for MinAttempts
+var leaderCategories = map[string]struct {
+	tableId   string
+	rowType   string
+	attempts  string
+	defaultBy string
+}{
+	"passing":   {"passing", "passing", "pass_att", "pass_yds"},
+	"rushing":   {"rushing", "rushing_and_receiving", "rush_att", "rush_yds"},
+	"receiving": {"receiving", "rushing_and_receiving", "targets", "rec_yds"},
+	"defense":   {"defense", "defense", "g", "tackles_combined"},
+	"kicking":   {"kicking", "kicking", "fga", "fgm"},
+	"punting":   {"punting", "punting", "punt", "punt_yds"},
+	"returns":   {"returns", "returns", "kick_ret", "all_purpose_yds"},
+	"scoring":   {"scoring", "scoring", "g", "scoring"},
+}
+
+func GetSeasonLeaders(url string, category string, filter LeaderFilter) (any, error) {
+	leaderCategory, exists := leaderCategories[category]
+	if !exists {
+		return nil, fmt.Errorf("unknown category %s", category)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return nil, err
+	}
+
+	// Filter and sort on the raw rows so any column can be used regardless of category
+	var rows []tableRow
+	for _, row := range readTableRows(findTable(doc, leaderCategory.tableId)) {
+		team := row.first("team", "team_name_abbr")
+		if teamHref := row.first("team_href", "team_name_abbr_href"); teamHref != "" {
+			team = teamFromHref(teamHref)
+		}
+
+		if filter.Team != "" && !strings.EqualFold(team, filter.Team) {
+			continue
+		}
+		if filter.Position != "" && !strings.EqualFold(row["pos"], filter.Position) {
+			continue
+		}
+		if row.intStat(leaderCategory.attempts) < filter.MinAttempts {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no %s leaders found for selected filters", category)
+	}
+
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = leaderCategory.defaultBy
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if filter.Ascending {
+			return rows[i].floatStat(sortBy) < rows[j].floatStat(sortBy)
+		}
+		return rows[i].floatStat(sortBy) > rows[j].floatStat(sortBy)
+	})
+
+	return parsePlayerStatRows(leaderCategory.rowType, rows), nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PlayoffSeed struct {
+	Conference string `json:"conference"`
+	Seed       int    `json:"seed"`
+	Team       string `json:"team"`
+	Name       string `json:"name"`
+}
+
+type PlayoffGame struct {
+	Date         string `json:"date"`
+	Winner       string `json:"winner"`
+	WinnerName   string `json:"winnerName"`
+	WinnerSeed   int    `json:"winnerSeed"`
+	WinnerPoints int    `json:"winnerPoints"`
+	Loser        string `json:"loser"`
+	LoserName    string `json:"loserName"`
+	LoserSeed    int    `json:"loserSeed"`
+	LoserPoints  int    `json:"loserPoints"`
+	HomeTeam     string `json:"homeTeam"`
+	AwayTeam     string `json:"awayTeam"`
+	Neutral      bool   `json:"neutral"`
+	Played       bool   `json:"played"` // false for pending games of a season in progress
+	BoxscoreId   string `json:"boxscoreId"`
+}
+
+type PlayoffRound struct {
+	Round        int           `json:"round"`
+	Name         string        `json:"name"`
+	Championship bool          `json:"championship"` // the game that decided the season's champion
+	LeagueTitle  bool          `json:"leagueTitle"`  // NFL or AFL championship, before the merger
+	Games        []PlayoffGame `json:"games"`
+}
+
+type PlayoffBracket struct {
+	Year   int            `json:"year"`
+	Seeds  []PlayoffSeed  `json:"seeds"`
+	Rounds []PlayoffRound `json:"rounds"`
+}
+
+// PFR week labels by era, unknown labels are passed through as is
+var playoffRoundNames = map[string]string{
+	"WildCard":      "Wild Card",
+	"Division":      "Divisional",
+	"Divisional":    "Divisional",
+	"ConfChamp":     "Conference Championship",
+	"Conference":    "Conference Championship",
+	"SuperBowl":     "Super Bowl",
+	"Champ":         "NFL Championship",
+	"NFLChamp":      "NFL Championship",
+	"AFLChamp":      "AFL Championship",
+	"AFL-Champ":     "AFL Championship",
+	"AFLDiv":        "AFL Divisional",
+	"AFL-Div":       "AFL Divisional",
+	"NFLConf":       "NFL Conference Championship",
+	"NFL-Conf":      "NFL Conference Championship",
+	"DivTiebreaker": "Division Tiebreaker",
+}
+
+// Pre-merger league finals, they crown the champion until the Super Bowl is played (1966 season on)
+var leagueTitleRounds = map[string]bool{
+	"Champ": true, "NFLChamp": true, "AFLChamp": true, "AFL-Champ": true,
+}
+
+func GetSeasonPlayoffs(url string, year int) (PlayoffBracket, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return PlayoffBracket{}, err
+	}
+
+	bracket := PlayoffBracket{
+		Year:   year,
+		Seeds:  []PlayoffSeed{},
+		Rounds: []PlayoffRound{},
+	}
+
+	// Seeds only exist once PFR splits playoff standings by conference (1970 on)
+	seedByTeam := map[string]int{}
+	for _, conference := range []string{"AFC", "NFC"} {
+		for _, row := range readTableRows(findTable(doc, strings.ToLower(conference)+"_playoff_standings")) {
+			seed := row.intStat("seed", "ranker")
+			team := teamFromHref(row["team_href"])
+			if seed == 0 || team == "" {
+				continue
+			}
+
+			seedByTeam[team] = seed
+			bracket.Seeds = append(bracket.Seeds, PlayoffSeed{
+				Conference: conference,
+				Seed:       seed,
+				Team:       team,
+				Name:       row.name("team"),
+			})
+		}
+	}
+
+	// Games are listed in date order, so rounds are numbered as they first appear
+	roundIndex := map[string]int{}
+	for _, row := range readTableRows(findTable(doc, "playoff_results")) {
+		result, ok := parseGameRow(row)
+		if !ok {
+			continue
+		}
+
+		label := row["week_num"]
+		i, exists := roundIndex[label]
+		if !exists {
+			name, known := playoffRoundNames[label]
+			if !known {
+				name = label
+			}
+
+			bracket.Rounds = append(bracket.Rounds, PlayoffRound{
+				Round:        len(bracket.Rounds) + 1,
+				Name:         name,
+				Championship: label == "SuperBowl" || leagueTitleRounds[label],
+				LeagueTitle:  leagueTitleRounds[label],
+				Games:        []PlayoffGame{},
+			})
+			i = len(bracket.Rounds) - 1
+			roundIndex[label] = i
+		}
+
+		game := PlayoffGame{
+			Date:         result.Date,
+			Winner:       result.Winner,
+			WinnerName:   result.WinnerName,
+			WinnerSeed:   seedByTeam[result.Winner],
+			WinnerPoints: result.WinnerPoints,
+			Loser:        result.Loser,
+			LoserName:    result.LoserName,
+			LoserSeed:    seedByTeam[result.Loser],
+			LoserPoints:  result.LoserPoints,
+			HomeTeam:     result.HomeTeam,
+			AwayTeam:     result.AwayTeam,
+			Neutral:      result.Neutral,
+			Played:       result.Played,
+			BoxscoreId:   result.BoxscoreId,
+		}
+
+		bracket.Rounds[i].Games = append(bracket.Rounds[i].Games, game)
+	}
+
+	if len(bracket.Rounds) == 0 {
+		return PlayoffBracket{}, fmt.Errorf("no playoff games found for year %d", year)
+	}
+
+	// 1966-69 played both league finals and a Super Bowl, only the Super Bowl decided the season
+	if _, exists := roundIndex["SuperBowl"]; exists {
+		for i := range bracket.Rounds {
+			if bracket.Rounds[i].LeagueTitle {
+				bracket.Rounds[i].Championship = false
+			}
+		}
+	}
+
+	return bracket, nil
+}
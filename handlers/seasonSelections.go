@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Selection struct {
+	Year         int    `json:"year"`
+	Organization string `json:"organization"`
+	Selection    string `json:"selection"`
+	AllProTeam   int    `json:"allProTeam,omitempty"`
+	Position     string `json:"position"`
+	PlayerId     string `json:"playerId"`
+	Name         string `json:"name"`
+	Team         string `json:"team"`
+}
+
+/*
+Gets All-Pro or Pro Bowl selections for a season
+- selectionType "allpro" reads years/{year}/allpro.htm, one entry per selecting organization (AP, PFWA, SN, etc.)
+- selectionType "probowl" reads years/{year}/probowl.htm
+*/
+func GetSeasonSelections(url string, tableId string, selectionType string, year int) ([]Selection, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []Selection{}, err
+	}
+
+	selections := []Selection{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		name := row.first("player", "name_display")
+		if name == "" {
+			continue
+		}
+
+		playerId := row.first("player_id", "name_display_id")
+		if playerId == "" {
+			playerId = idFromHref(row.first("player_href", "name_display_href"))
+		}
+
+		team := row.first("team", "team_name_abbr")
+		if teamHref := row.first("team_href", "team_name_abbr_href"); teamHref != "" {
+			team = teamFromHref(teamHref)
+		}
+
+		selection := Selection{
+			Year:     year,
+			Position: row["pos"],
+			PlayerId: playerId,
+			Name:     cleanName(name),
+			Team:     team,
+		}
+
+		if selectionType == "probowl" {
+			selection.Organization = "NFL"
+			selection.Selection = "Pro Bowl"
+			selections = append(selections, selection)
+			continue
+		}
+
+		// "AP: 1st Tm, PFWA: 1st Tm, SN: 2nd Tm" -> one selection per organization
+		for _, vote := range strings.Split(row.first("all_pro_string", "all_pro_teams"), ",") {
+			organization, allProTeam, found := strings.Cut(strings.TrimSpace(vote), ":")
+			if !found {
+				continue
+			}
+
+			orgSelection := selection
+			orgSelection.Organization = strings.TrimSpace(organization)
+			orgSelection.Selection = strings.TrimSpace(allProTeam)
+			switch {
+			case strings.HasPrefix(orgSelection.Selection, "1st"):
+				orgSelection.AllProTeam = 1
+			case strings.HasPrefix(orgSelection.Selection, "2nd"):
+				orgSelection.AllProTeam = 2
+			}
+			selections = append(selections, orgSelection)
+		}
+	}
+
+	if len(selections) == 0 {
+		return []Selection{}, fmt.Errorf("no %s selections found for year %d", selectionType, year)
+	}
+
+	return selections, nil
+}
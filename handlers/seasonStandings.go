@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -27,6 +28,7 @@ type TeamSeason struct {
 
 type Conference struct {
 	Name      string     `json:"name"`
+	League    string     `json:"league"`
 	Divisions []Division `json:"divisions"`
 }
 
@@ -35,9 +37,10 @@ type Division struct {
 	Teams []TeamSeason `json:"teams"`
 }
 
-func GetLeagueStandingsByYearPre1970(url string) ([]Conference, error) {
-	tableSelector := "#NFL"
+// Leagues with standings tables on PFR season pages before the merger, table ids match the league name
+var pre1970Leagues = []string{"NFL", "APFA", "AFL", "AAFC"}
 
+func GetLeagueStandingsByYearPre1970(url string) ([]Conference, error) {
 	// ---- CLIENT BOILERPLATE ----
 	client := &http.Client{
 		Timeout: 4 * (time.Second + 8),
@@ -100,71 +103,44 @@ func GetLeagueStandingsByYearPre1970(url string) ([]Conference, error) {
 	}
 
 	var tableData [][]string
-	nfl := Conference{"NFL", []Division{}}
-	divisionOfInterest := Division{"No", []TeamSeason{}}
+	var league []Conference
 
 	// ---- END ----
 
-	doc.Find(tableSelector).Find("tr").Each(func(i int, row *goquery.Selection) {
-		var rowData []string
-		row.Find("td, th").Each(func(j int, cell *goquery.Selection) {
-			rowData = append(rowData, cell.Text())
-		})
-
-		if len(rowData) == 1 {
-			if divisionOfInterest.Name != "No" {
-				nfl.Divisions = append(nfl.Divisions, divisionOfInterest)
-			}
-			divisionOfInterest = Division{rowData[0], []TeamSeason{}}
-		}
-
-		if len(rowData) > 1 && rowData[0] != "Tm" {
-			println(rowData[0])
-			tableData = append(tableData, rowData)
-			if err == nil {
-
-				team := rowData[0]
-				if team[len(team)-1] == '*' || team[len(team)-1] == '+' {
-					team = team[:len(team)-1]
-				}
-
-				wins, _ := strconv.Atoi(rowData[1])
-				losses, _ := strconv.Atoi(rowData[2])
-				winLossPerc, _ := strconv.ParseFloat(rowData[3], 64)
-				pointsFor, _ := strconv.Atoi(rowData[4])
-				pointsAgainst, _ := strconv.Atoi(rowData[5])
-				pointsDif, _ := strconv.Atoi(rowData[6])
-				marginOfVictory, _ := strconv.ParseFloat(rowData[7], 64)
-				strengthOfSchedule, _ := strconv.ParseFloat(rowData[8], 64)
-				srs, _ := strconv.ParseFloat(rowData[9], 64)
-				offensiveSrs, _ := strconv.ParseFloat(rowData[10], 64)
-				defensiveSrs, _ := strconv.ParseFloat(rowData[11], 64)
-
-				season := TeamSeason{
-					Team:               team,
-					Wins:               wins,
-					Losses:             losses,
-					WinLossPerc:        winLossPerc,
-					PointsFor:          pointsFor,
-					PointsAgainst:      pointsAgainst,
-					PointsDif:          pointsDif,
-					MarginOfVictory:    marginOfVictory,
-					StrengthOfSchedule: strengthOfSchedule,
-					Srs:                srs,
-					OffensiveSrs:       offensiveSrs,
-					DefensiveSrs:       defensiveSrs,
+	// Every league PFR has a standings table for, only the first is shown on the page, the rest are commented out
+	for _, leagueName := range pre1970Leagues {
+		conference := Conference{leagueName, leagueName, []Division{}}
+		divisionOfInterest := Division{"No", []TeamSeason{}}
+		leagueRows := 0
+
+		findTable(doc, leagueName).Find("tr").Each(func(i int, row *goquery.Selection) {
+			var rowData []string
+			row.Find("td, th").Each(func(j int, cell *goquery.Selection) {
+				rowData = append(rowData, cell.Text())
+			})
+
+			if len(rowData) == 1 {
+				if divisionOfInterest.Name != "No" {
+					conference.Divisions = append(conference.Divisions, divisionOfInterest)
 				}
+				divisionOfInterest = Division{rowData[0], []TeamSeason{}}
+			}
 
+			if season, ok := parseStandingsRow(row); ok {
+				tableData = append(tableData, rowData)
+				leagueRows++
 				divisionOfInterest.Teams = append(divisionOfInterest.Teams, season)
 			}
-		}
-	})
+		})
 
-	// catch hanging division
-	nfl.Divisions = append(nfl.Divisions, divisionOfInterest)
+		if leagueRows == 0 {
+			continue
+		}
 
-	var league []Conference
-	league = append(league, nfl)
+		// catch hanging division
+		conference.Divisions = append(conference.Divisions, divisionOfInterest)
+		league = append(league, conference)
+	}
 
 	if len(tableData) < 1 {
 		return []Conference{}, fmt.Errorf("no data found for selected year")
@@ -239,8 +215,8 @@ func GetLeagueStandingsByYearPost1970(url string) ([]Conference, error) {
 	// ---- END ----
 
 	var tableData [][]string
-	afc := Conference{"AFC", []Division{}}
-	nfc := Conference{"NFC", []Division{}}
+	afc := Conference{"AFC", "NFL", []Division{}}
+	nfc := Conference{"NFC", "NFL", []Division{}}
 	divisionOfInterest := Division{"No", []TeamSeason{}}
 
 	// Get AFC tables
@@ -257,44 +233,9 @@ func GetLeagueStandingsByYearPost1970(url string) ([]Conference, error) {
 			divisionOfInterest = Division{rowData[0], []TeamSeason{}}
 		}
 
-		if len(rowData) > 1 && rowData[0] != "Tm" {
+		if season, ok := parseStandingsRow(row); ok {
 			tableData = append(tableData, rowData)
-			if err == nil {
-
-				team := rowData[0]
-				if team[len(team)-1] == '*' || team[len(team)-1] == '+' {
-					team = team[:len(team)-1]
-				}
-
-				wins, _ := strconv.Atoi(rowData[1])
-				losses, _ := strconv.Atoi(rowData[2])
-				winLossPerc, _ := strconv.ParseFloat(rowData[3], 64)
-				pointsFor, _ := strconv.Atoi(rowData[4])
-				pointsAgainst, _ := strconv.Atoi(rowData[5])
-				pointsDif, _ := strconv.Atoi(rowData[6])
-				marginOfVictory, _ := strconv.ParseFloat(rowData[7], 64)
-				strengthOfSchedule, _ := strconv.ParseFloat(rowData[8], 64)
-				srs, _ := strconv.ParseFloat(rowData[9], 64)
-				offensiveSrs, _ := strconv.ParseFloat(rowData[10], 64)
-				defensiveSrs, _ := strconv.ParseFloat(rowData[11], 64)
-
-				season := TeamSeason{
-					Team:               team,
-					Wins:               wins,
-					Losses:             losses,
-					WinLossPerc:        winLossPerc,
-					PointsFor:          pointsFor,
-					PointsAgainst:      pointsAgainst,
-					PointsDif:          pointsDif,
-					MarginOfVictory:    marginOfVictory,
-					StrengthOfSchedule: strengthOfSchedule,
-					Srs:                srs,
-					OffensiveSrs:       offensiveSrs,
-					DefensiveSrs:       defensiveSrs,
-				}
-
-				divisionOfInterest.Teams = append(divisionOfInterest.Teams, season)
-			}
+			divisionOfInterest.Teams = append(divisionOfInterest.Teams, season)
 		}
 	})
 
@@ -316,44 +257,9 @@ func GetLeagueStandingsByYearPost1970(url string) ([]Conference, error) {
 			divisionOfInterest = Division{rowData[0], []TeamSeason{}}
 		}
 
-		if len(rowData) > 1 && rowData[0] != "Tm" {
+		if season, ok := parseStandingsRow(row); ok {
 			tableData = append(tableData, rowData)
-			if err == nil {
-
-				team := rowData[0]
-				if team[len(team)-1] == '*' || team[len(team)-1] == '+' {
-					team = team[:len(team)-1]
-				}
-
-				wins, _ := strconv.Atoi(rowData[1])
-				losses, _ := strconv.Atoi(rowData[2])
-				winLossPerc, _ := strconv.ParseFloat(rowData[3], 64)
-				pointsFor, _ := strconv.Atoi(rowData[4])
-				pointsAgainst, _ := strconv.Atoi(rowData[5])
-				pointsDif, _ := strconv.Atoi(rowData[6])
-				marginOfVictory, _ := strconv.ParseFloat(rowData[7], 64)
-				strengthOfSchedule, _ := strconv.ParseFloat(rowData[8], 64)
-				srs, _ := strconv.ParseFloat(rowData[9], 64)
-				offensiveSrs, _ := strconv.ParseFloat(rowData[10], 64)
-				defensiveSrs, _ := strconv.ParseFloat(rowData[11], 64)
-
-				season := TeamSeason{
-					Team:               team,
-					Wins:               wins,
-					Losses:             losses,
-					WinLossPerc:        winLossPerc,
-					PointsFor:          pointsFor,
-					PointsAgainst:      pointsAgainst,
-					PointsDif:          pointsDif,
-					MarginOfVictory:    marginOfVictory,
-					StrengthOfSchedule: strengthOfSchedule,
-					Srs:                srs,
-					OffensiveSrs:       offensiveSrs,
-					DefensiveSrs:       defensiveSrs,
-				}
-
-				divisionOfInterest.Teams = append(divisionOfInterest.Teams, season)
-			}
+			divisionOfInterest.Teams = append(divisionOfInterest.Teams, season)
 		}
 	})
 
@@ -370,3 +276,45 @@ func GetLeagueStandingsByYearPost1970(url string) ([]Conference, error) {
 
 	return league, nil
 }
+
+// Reads a standings row by data-stat, header and division rows return false. Older tables add a ties
+// column and some leagues lack the SRS columns, so column positions can't be relied on
+func parseStandingsRow(row *goquery.Selection) (TeamSeason, bool) {
+	stat := func(key string) string {
+		return strings.TrimSpace(row.Find("[data-stat=" + key + "]").Text())
+	}
+
+	team := cleanName(stat("team"))
+	if team == "" || team == "Tm" {
+		return TeamSeason{}, false
+	}
+
+	wins, _ := strconv.Atoi(stat("wins"))
+	losses, _ := strconv.Atoi(stat("losses"))
+	winLossPerc, _ := strconv.ParseFloat(stat("win_loss_perc"), 64)
+	pointsFor, _ := strconv.Atoi(stat("points"))
+	pointsAgainst, _ := strconv.Atoi(stat("points_opp"))
+	pointsDif, _ := strconv.Atoi(stat("points_diff"))
+	marginOfVictory, _ := strconv.ParseFloat(stat("mov"), 64)
+	strengthOfSchedule, _ := strconv.ParseFloat(stat("sos_total"), 64)
+	srs, _ := strconv.ParseFloat(stat("srs_total"), 64)
+	offensiveSrs, _ := strconv.ParseFloat(stat("srs_offense"), 64)
+	defensiveSrs, _ := strconv.ParseFloat(stat("srs_defense"), 64)
+
+	season := TeamSeason{
+		Team:               team,
+		Wins:               wins,
+		Losses:             losses,
+		WinLossPerc:        winLossPerc,
+		PointsFor:          pointsFor,
+		PointsAgainst:      pointsAgainst,
+		PointsDif:          pointsDif,
+		MarginOfVictory:    marginOfVictory,
+		StrengthOfSchedule: strengthOfSchedule,
+		Srs:                srs,
+		OffensiveSrs:       offensiveSrs,
+		DefensiveSrs:       defensiveSrs,
+	}
+
+	return season, true
+}
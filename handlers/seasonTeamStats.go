@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type SeasonTeamStats struct {
+	Team               string      `json:"team"`
+	Name               string      `json:"name"`
+	Offense            Stats       `json:"offense"`
+	Defense            Stats       `json:"defense"`
+	OffenseConversions Conversions `json:"offenseConversions"`
+	DefenseConversions Conversions `json:"defenseConversions"`
+}
+
+/*
+Gets offense and defense tables for every team in a season
+- offenseUrl is the season page (years/{year}/), defenseUrl its opponent page (years/{year}/opp.htm)
+*/
+func GetSeasonTeamStats(offenseUrl string, defenseUrl string, year int) ([]SeasonTeamStats, error) {
+	offenseDoc, err := fetchDoc(offenseUrl)
+	if err != nil {
+		return []SeasonTeamStats{}, err
+	}
+
+	defenseDoc, err := fetchDoc(defenseUrl)
+	if err != nil {
+		return []SeasonTeamStats{}, err
+	}
+
+	var res []SeasonTeamStats
+	teamIndex := map[string]int{}
+
+	// Offense table decides team order (PFR ranks it by points scored)
+	for _, row := range readTableRows(findTable(offenseDoc, "team_stats")) {
+		team := teamFromHref(row["team_href"])
+		if team == "" {
+			continue
+		}
+
+		teamIndex[team] = len(res)
+		res = append(res, SeasonTeamStats{
+			Team: team,
+			Name: row.name("team"),
+		})
+	}
+
+	if len(res) == 0 {
+		return []SeasonTeamStats{}, fmt.Errorf("no data found for selected year")
+	}
+
+	for _, side := range []struct {
+		doc      *goquery.Document
+		dataType string
+	}{
+		{offenseDoc, "Team Offense"},
+		{defenseDoc, "Team Defense"},
+	} {
+		drives := map[string]tableRow{}
+		for _, row := range readTableRows(findTable(side.doc, "drives")) {
+			drives[teamFromHref(row["team_href"])] = row
+		}
+
+		for _, row := range readTableRows(findTable(side.doc, "team_stats")) {
+			i, exists := teamIndex[teamFromHref(row["team_href"])]
+			if !exists {
+				continue
+			}
+
+			stats := parseSeasonTeamStatsRow(row, drives[res[i].Team], res[i].Team, year, side.dataType)
+			if side.dataType == "Team Offense" {
+				res[i].Offense = stats
+			} else {
+				res[i].Defense = stats
+			}
+		}
+
+		for _, row := range readTableRows(findTable(side.doc, "team_conversions")) {
+			i, exists := teamIndex[teamFromHref(row["team_href"])]
+			if !exists {
+				continue
+			}
+
+			conversions := Conversions{
+				DataType:              side.dataType,
+				ThirdDownAttempts:     row.intStat("third_down_att"),
+				ThirdDownConversions:  row.intStat("third_down_success"),
+				ThirdDownPerc:         row.floatStat("third_down_success_pct") / 100,
+				FourthDownAttempts:    row.intStat("fourth_down_att"),
+				FourthDownConversions: row.intStat("fourth_down_success"),
+				FourthDownPerc:        row.floatStat("fourth_down_success_pct") / 100,
+				RedZoneAttempts:       row.intStat("red_zone_att"),
+				RedZoneTDs:            row.intStat("red_zone_scores"),
+				RedZonePerc:           row.floatStat("red_zone_pct") / 100,
+			}
+			if side.dataType == "Team Offense" {
+				res[i].OffenseConversions = conversions
+			} else {
+				res[i].DefenseConversions = conversions
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Fills Stats from a team_stats row and the matching drives row, drive fields stay 0 before PFR tracked drives
+func parseSeasonTeamStatsRow(row tableRow, drive tableRow, team string, year int, dataType string) Stats {
+	stats := Stats{
+		Team:              team,
+		Year:              year,
+		DataType:          dataType,
+		PointsFor:         row.intStat("points"),
+		TotalYards:        row.intStat("total_yards"),
+		TotalPlays:        row.intStat("plays_offense"),
+		YardsPerPlay:      row.floatStat("yds_per_play_offense"),
+		Turnovers:         row.intStat("turnovers"),
+		Fumbles:           row.intStat("fumbles_lost"),
+		FirstDowns:        row.intStat("first_down"),
+		PassCompletions:   row.intStat("pass_cmp"),
+		PassAttempts:      row.intStat("pass_att"),
+		PassYards:         row.intStat("pass_yds"),
+		PassTds:           row.intStat("pass_td"),
+		PassInts:          row.intStat("pass_int"),
+		PassYardsPerAtt:   row.floatStat("pass_net_yds_per_att"),
+		PassFirstDowns:    row.intStat("pass_fd"),
+		RushAttempts:      row.intStat("rush_att"),
+		RushYards:         row.intStat("rush_yds"),
+		RushTDs:           row.intStat("rush_td"),
+		RushYardsPerAtt:   row.floatStat("rush_yds_per_att"),
+		RushFirstDowns:    row.intStat("rush_fd"),
+		Penalties:         row.intStat("penalties"),
+		PenaltyYards:      row.intStat("penalties_yds"),
+		PenaltyFirstDowns: row.intStat("pen_fd"),
+	}
+
+	if drive == nil {
+		return stats
+	}
+
+	stats.Drives = drive.intStat("drives")
+	stats.ScoringDrivePercentage = drive.floatStat("score_pct") / 100
+	stats.TurnoverDrivePercentage = drive.floatStat("turnover_pct") / 100
+
+	// "Own 28.3" -> 28.3
+	startAvg := drive["start_avg"]
+	stats.AverageStartPosition, _ = strconv.ParseFloat(startAvg[strings.LastIndex(startAvg, " ")+1:], 64)
+
+	// "2:45" -> 2.75 minutes
+	if minutes, seconds, found := strings.Cut(drive["time_avg"], ":"); found {
+		minute, _ := strconv.ParseFloat(minutes, 64)
+		second, _ := strconv.ParseFloat(seconds, 64)
+		stats.AvgDriveLength = minute + (second / 60)
+	}
+
+	stats.AvgDrivePlays = drive.floatStat("plays_per_drive")
+	stats.AvgDriveYards = drive.floatStat("yds_per_drive")
+	stats.AvgDrivePoints = drive.floatStat("points_avg")
+
+	return stats
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Most PFR tables past the first one on a page are shipped inside an HTML comment and
+// rendered client side. These helpers find a table whether or not it is commented out,
+// and read its rows keyed by each cell's "data-stat" attribute instead of column position.
+
+type tableRow map[string]string
+
+// Finds table by id, falling back to the commented copy inside its "#all_<id>" wrapper
+func findTable(doc *goquery.Document, tableId string) *goquery.Selection {
+	table := doc.Find("#" + tableId)
+	if table.Length() > 0 {
+		return table
+	}
+
+	html, err := doc.Find("#all_" + tableId).Html()
+	if err != nil || html == "" {
+		return table
+	}
+
+	// Remove comment symbols and parse the table as its own doc
+	commentMarkersRegex := regexp.MustCompile(`<!--|-->`)
+	cleanHtml := commentMarkersRegex.ReplaceAllString(html, "")
+
+	hidden, err := goquery.NewDocumentFromReader(strings.NewReader(cleanHtml))
+	if err != nil {
+		return table
+	}
+
+	return hidden.Find("#" + tableId)
+}
+
+/*
+Reads every body row of a table into a map of data-stat -> cell text. Repeated header rows are skipped.
+For each cell also stores:
+- "<stat>_id" from the cell's data-append-csv attribute (PFR player ids)
+- "<stat>_href" from the first link in the cell
+- "<stat>_hrefs" from every link in the cell, space separated, when there is more than one
+*/
+func readTableRows(table *goquery.Selection) []tableRow {
+	var rows []tableRow
+
+	table.Find("tbody tr").Each(func(i int, row *goquery.Selection) {
+		if row.HasClass("thead") || row.HasClass("over_header") || row.HasClass("spacer") {
+			return
+		}
+
+		rowData := tableRow{}
+		row.Find("td, th").Each(func(j int, cell *goquery.Selection) {
+			stat, exists := cell.Attr("data-stat")
+			if !exists {
+				return
+			}
+
+			rowData[stat] = strings.TrimSpace(cell.Text())
+			if id, exists := cell.Attr("data-append-csv"); exists {
+				rowData[stat+"_id"] = id
+			}
+			if href, exists := cell.Find("a").First().Attr("href"); exists {
+				rowData[stat+"_href"] = href
+			}
+			if links := cell.Find("a"); links.Length() > 1 {
+				rowData[stat+"_hrefs"] = strings.Join(links.Map(func(k int, a *goquery.Selection) string {
+					return a.AttrOr("href", "")
+				}), " ")
+			}
+		})
+
+		if len(rowData) > 0 {
+			rows = append(rows, rowData)
+		}
+	})
+
+	return rows
+}
+
+// Returns the value of the first data-stat key present in the row, PFR has renamed several columns over time
+func (row tableRow) first(keys ...string) string {
+	for _, key := range keys {
+		if value, exists := row[key]; exists {
+			return value
+		}
+	}
+	return ""
+}
+
+// "/players/M/MahoPa00.htm" -> "MahoPa00", "/teams/kan/2023.htm" -> "2023"
+func idFromHref(href string) string {
+	href = strings.TrimSuffix(href, "/")
+	href = href[strings.LastIndex(href, "/")+1:]
+	return strings.TrimSuffix(href, ".htm")
+}
+
+// "/teams/kan/2023.htm" -> "kan"
+func teamFromHref(href string) string {
+	parts := strings.Split(strings.Trim(href, "/"), "/")
+	if len(parts) < 2 || parts[0] != "teams" {
+		return ""
+	}
+	return parts[1]
+}
+
+// Reads a stat as an int, "" and non numeric cells are 0
+func (row tableRow) intStat(keys ...string) int {
+	value, _ := strconv.Atoi(strings.ReplaceAll(row.first(keys...), ",", ""))
+	return value
+}
+
+// Reads a stat as a float, percentage signs are dropped but the value is not rescaled
+func (row tableRow) floatStat(keys ...string) float64 {
+	value, _ := strconv.ParseFloat(strings.TrimSuffix(strings.ReplaceAll(row.first(keys...), ",", ""), "%"), 64)
+	return value
+}
+
+// Drops PFR's honor markers from a name, "*" Pro Bowl and "+" First-team All-Pro: "Aaron Rodgers*+" -> "Aaron Rodgers"
+func cleanName(name string) string {
+	return strings.TrimRight(strings.TrimSpace(name), "*+ ")
+}
+
+// First non empty stat of keys read as a name, see cleanName
+func (row tableRow) name(keys ...string) string {
+	return cleanName(row.first(keys...))
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type BettingLine struct {
+	Week            string  `json:"week"`
+	Opponent        string  `json:"opponent"`
+	BoxscoreId      string  `json:"boxscoreId"`
+	Spread          float64 `json:"spread"` // negative when team is favored
+	OverUnder       float64 `json:"overUnder"`
+	PointsFor       int     `json:"pointsFor"`
+	PointsAgainst   int     `json:"pointsAgainst"`
+	HasSpread       bool    `json:"hasSpread"` // false when neither PFR page lists a spread, ATS results are left empty
+	HasTotal        bool    `json:"hasTotal"`  // false when neither PFR page lists a total, over/under results are left empty
+	ATSResult       string  `json:"atsResult"` // Covered, Not Covered or Push
+	CoverMargin     float64 `json:"coverMargin"`
+	OverUnderResult string  `json:"overUnderResult"` // Over, Under or Push
+	TotalMargin     float64 `json:"totalMargin"`
+}
+
+type ATSSummary struct {
+	Wins            int     `json:"wins"`
+	Losses          int     `json:"losses"`
+	Pushes          int     `json:"pushes"`
+	Overs           int     `json:"overs"`
+	Unders          int     `json:"unders"`
+	OverUnderPushes int     `json:"overUnderPushes"`
+	AvgCoverMargin  float64 `json:"avgCoverMargin"`
+	FavoriteRecord  string  `json:"favoriteRecord"` // ATS as favorite, "W-L-P"
+	UnderdogRecord  string  `json:"underdogRecord"` // ATS as underdog, "W-L-P"
+}
+
+type TeamBetting struct {
+	Team    string        `json:"team"`
+	Year    int           `json:"year"`
+	Summary ATSSummary    `json:"summary"`
+	Games   []BettingLine `json:"games"`
+}
+
+var gameScoreRegex = regexp.MustCompile(`(\d+)-(\d+)`)
+
+/*
+Gets spread, total and against-the-spread results for each game of a team's season, see the team's {year}_lines.htm page
+Team is the PFR team code the page was requested with, not the full name SeasonOverlook.Team holds
+Games the lines page has no line for fall back to the boxscore Vegas Line and Over/Under entries
+*/
+func GetTeamBetting(url string, tableId string, team string, year int) (TeamBetting, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamBetting{}, err
+	}
+
+	franchise, _ := GetFranchise(team)
+	teamName := franchise.NameInYear(year)
+
+	games := []BettingLine{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		result := row.first("game_result", "result")
+		score := gameScoreRegex.FindStringSubmatch(result)
+		if score == nil {
+			continue // unplayed
+		}
+
+		line := BettingLine{
+			Week:       row.first("week_num", "game_num"),
+			Opponent:   teamFromHref(row.first("opp_href", "opp_name_href")),
+			BoxscoreId: idFromHref(row.first("game_result_href", "boxscore_word_href")),
+			Spread:     row.floatStat("vegas_line", "spread"),
+			OverUnder:  row.floatStat("over_under"),
+			HasSpread:  row.first("vegas_line", "spread") != "",
+			HasTotal:   row.first("over_under") != "",
+		}
+
+		// "W 27-20" / "L 17-24", the result decides which score is the team's
+		high, _ := strconv.Atoi(score[1])
+		low, _ := strconv.Atoi(score[2])
+		if high < low {
+			high, low = low, high
+		}
+		line.PointsFor, line.PointsAgainst = high, low
+		if strings.HasPrefix(result, "L") {
+			line.PointsFor, line.PointsAgainst = low, high
+		}
+
+		if (!line.HasSpread || !line.HasTotal) && line.BoxscoreId != "" && teamName != "" {
+			boxscoreUrl := "https://www.pro-football-reference.com/boxscores/" + line.BoxscoreId + ".htm"
+			if gameLine, err := GetGameLine(boxscoreUrl, teamName); err == nil {
+				if !line.HasSpread && gameLine.HasSpread {
+					line.Spread, line.HasSpread = gameLine.Spread, true
+				}
+				if !line.HasTotal && gameLine.HasTotal {
+					line.OverUnder, line.HasTotal = gameLine.OverUnder, true
+				}
+			}
+		}
+
+		// A missing spread or total would otherwise grade as a pick'em or a 0 total
+		if line.HasSpread {
+			line.CoverMargin = float64(line.PointsFor-line.PointsAgainst) + line.Spread
+			line.ATSResult = betResult(line.CoverMargin, "Covered", "Not Covered")
+		}
+		if line.HasTotal {
+			line.TotalMargin = float64(line.PointsFor+line.PointsAgainst) - line.OverUnder
+			line.OverUnderResult = betResult(line.TotalMargin, "Over", "Under")
+		}
+
+		games = append(games, line)
+	}
+
+	if len(games) == 0 {
+		return TeamBetting{}, fmt.Errorf("no betting lines found for %s %d", team, year)
+	}
+
+	return TeamBetting{
+		Team:    team,
+		Year:    year,
+		Summary: summarizeATS(games),
+		Games:   games,
+	}, nil
+}
+
+func betResult(margin float64, above string, below string) string {
+	if margin > 0 {
+		return above
+	} else if margin < 0 {
+		return below
+	}
+	return "Push"
+}
+
+func summarizeATS(games []BettingLine) ATSSummary {
+	var summary ATSSummary
+	var favorite, underdog [3]int
+	var totalCoverMargin float64
+	graded := 0
+
+	for _, game := range games {
+		switch game.OverUnderResult {
+		case "Over":
+			summary.Overs++
+		case "Under":
+			summary.Unders++
+		case "Push":
+			summary.OverUnderPushes++
+		}
+
+		if !game.HasSpread {
+			continue
+		}
+		graded++
+
+		record := &underdog
+		if game.Spread < 0 {
+			record = &favorite
+		}
+
+		switch game.ATSResult {
+		case "Covered":
+			summary.Wins++
+			record[0]++
+		case "Not Covered":
+			summary.Losses++
+			record[1]++
+		default:
+			summary.Pushes++
+			record[2]++
+		}
+
+		totalCoverMargin += game.CoverMargin
+	}
+
+	if graded > 0 {
+		summary.AvgCoverMargin = totalCoverMargin / float64(graded)
+	}
+	summary.FavoriteRecord = fmt.Sprintf("%d-%d-%d", favorite[0], favorite[1], favorite[2])
+	summary.UnderdogRecord = fmt.Sprintf("%d-%d-%d", underdog[0], underdog[1], underdog[2])
+
+	return summary
+}
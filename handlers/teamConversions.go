@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"fmt"
+)
+
+type Conversions struct {
+	DataType              string  `json:"dataType"`
+	ThirdDownAttempts     int     `json:"thirdDownAttempts"`
+	ThirdDownConversions  int     `json:"thirdDownConversions"`
+	ThirdDownPerc         float64 `json:"thirdDownPerc"`
+	FourthDownAttempts    int     `json:"fourthDownAttempts"`
+	FourthDownConversions int     `json:"fourthDownConversions"`
+	FourthDownPerc        float64 `json:"fourthDownPerc"`
+	RedZoneAttempts       int     `json:"redZoneAttempts"`
+	RedZoneTDs            int     `json:"redZoneTds"`
+	RedZonePerc           float64 `json:"redZonePerc"`
+}
+
+// int only rankings
+type ConversionRankings struct {
+	DataType              string `json:"dataType"`
+	ThirdDownAttempts     int    `json:"thirdDownAttempts"`
+	ThirdDownConversions  int    `json:"thirdDownConversions"`
+	ThirdDownPerc         int    `json:"thirdDownPerc"`
+	FourthDownAttempts    int    `json:"fourthDownAttempts"`
+	FourthDownConversions int    `json:"fourthDownConversions"`
+	FourthDownPerc        int    `json:"fourthDownPerc"`
+	RedZoneAttempts       int    `json:"redZoneAttempts"`
+	RedZoneTDs            int    `json:"redZoneTds"`
+	RedZonePerc           int    `json:"redZonePerc"`
+}
+
+type TeamConversions struct {
+	Team            string             `json:"team"`
+	Year            int                `json:"year"`
+	Offense         Conversions        `json:"offense"`
+	Defense         Conversions        `json:"defense"`
+	OffenseRankings ConversionRankings `json:"offenseRankings"`
+	DefenseRankings ConversionRankings `json:"defenseRankings"`
+}
+
+func GetTeamConversions(url string, tableId string, year int, team string) (TeamConversions, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamConversions{}, err
+	}
+
+	// Rows are Team Stats, Opp. Stats, Lg Rank Offense, Lg Rank Defense
+	rows := readTableRows(findTable(doc, tableId))
+	if len(rows) < 4 {
+		return TeamConversions{}, fmt.Errorf("no data found for selected year")
+	}
+
+	var resStats []Conversions
+	for i := 0; i < 2; i++ {
+		row := rows[i]
+		resStats = append(resStats, Conversions{
+			DataType:              row.first("player", "team_stat"),
+			ThirdDownAttempts:     row.intStat("third_down_att"),
+			ThirdDownConversions:  row.intStat("third_down_success"),
+			ThirdDownPerc:         row.floatStat("third_down_success_pct") / 100,
+			FourthDownAttempts:    row.intStat("fourth_down_att"),
+			FourthDownConversions: row.intStat("fourth_down_success"),
+			FourthDownPerc:        row.floatStat("fourth_down_success_pct") / 100,
+			RedZoneAttempts:       row.intStat("red_zone_att"),
+			RedZoneTDs:            row.intStat("red_zone_scores"),
+			RedZonePerc:           row.floatStat("red_zone_pct") / 100,
+		})
+	}
+
+	var resRankings []ConversionRankings
+	for i := 2; i < 4; i++ {
+		row := rows[i]
+		resRankings = append(resRankings, ConversionRankings{
+			DataType:              row.first("player", "team_stat"),
+			ThirdDownAttempts:     row.intStat("third_down_att"),
+			ThirdDownConversions:  row.intStat("third_down_success"),
+			ThirdDownPerc:         row.intStat("third_down_success_pct"),
+			FourthDownAttempts:    row.intStat("fourth_down_att"),
+			FourthDownConversions: row.intStat("fourth_down_success"),
+			FourthDownPerc:        row.intStat("fourth_down_success_pct"),
+			RedZoneAttempts:       row.intStat("red_zone_att"),
+			RedZoneTDs:            row.intStat("red_zone_scores"),
+			RedZonePerc:           row.intStat("red_zone_pct"),
+		})
+	}
+
+	conversions := TeamConversions{
+		Team:            team,
+		Year:            year,
+		Offense:         resStats[0],
+		Defense:         resStats[1],
+		OffenseRankings: resRankings[0],
+		DefenseRankings: resRankings[1],
+	}
+
+	return conversions, nil
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
@@ -14,8 +16,12 @@ type DraftPick struct {
 	Year          int    `json:"year"`
 	Round         int    `json:"round"`
 	Name          string `json:"name"`
+	PlayerId      string `json:"playerId"`
+	HallOfFame    bool   `json:"hallOfFame"`
+	Team          string `json:"team,omitempty"`
 	Pick          int    `json:"pick"`
 	Position      string `json:"position"`
+	Age           int    `json:"age,omitempty"`
 	LastSeason    int    `json:"lastSeason"`
 	FirstAllPro   int    `json:"firstAllPro"`
 	ProBowl       int    `json:"proBowl"`
@@ -38,7 +44,64 @@ type DraftPick struct {
 	College       string `json:"college"`
 }
 
+type DraftGroup struct {
+	Year  int         `json:"year,omitempty"`
+	Round int         `json:"round,omitempty"`
+	Picks []DraftPick `json:"picks"`
+}
+
 func GetDraftYear(url string, tableSelector string, year int) ([]DraftPick, error) {
+	return getDraftYears(url, tableSelector, year, year)
+}
+
+/*
+Gets every pick between from and to (inclusive) from a single fetch of the franchise draft table
+Specify groupBy as "year" or "round" to group picks, anything else returns one group holding every pick
+*/
+func GetDraftHistory(url string, tableSelector string, from int, to int, groupBy string) ([]DraftGroup, error) {
+	picks, err := getDraftYears(url, tableSelector, from, to)
+	if err != nil {
+		return []DraftGroup{}, err
+	}
+
+	if groupBy != "year" && groupBy != "round" {
+		return []DraftGroup{{Picks: picks}}, nil
+	}
+
+	var groups []DraftGroup
+	groupIndex := map[int]int{}
+	for _, pick := range picks {
+		key := pick.Year
+		if groupBy == "round" {
+			key = pick.Round
+		}
+
+		i, exists := groupIndex[key]
+		if !exists {
+			group := DraftGroup{Picks: []DraftPick{}}
+			if groupBy == "round" {
+				group.Round = key
+			} else {
+				group.Year = key
+			}
+			groups = append(groups, group)
+			i = len(groups) - 1
+			groupIndex[key] = i
+		}
+		groups[i].Picks = append(groups[i].Picks, pick)
+	}
+
+	// Table is listed newest first, rounds come out in draft order
+	if groupBy == "round" {
+		sort.Slice(groups, func(i, j int) bool {
+			return groups[i].Round < groups[j].Round
+		})
+	}
+
+	return groups, nil
+}
+
+func getDraftYears(url string, tableSelector string, from int, to int) ([]DraftPick, error) {
 	// ---- CLIENT BOILERPLATE ----
 	client := &http.Client{
 		Timeout: 4 * (time.Second + 8),
@@ -105,6 +168,7 @@ func GetDraftYear(url string, tableSelector string, year int) ([]DraftPick, erro
 	// ---- END ----
 
 	var draft [][]string
+	var playerIds []string
 	doc.Find(tableSelector).Find("tr").Each(func(i int, row *goquery.Selection) {
 		var rowData []string
 		row.Find("td, th").Each(func(j int, cell *goquery.Selection) {
@@ -113,23 +177,26 @@ func GetDraftYear(url string, tableSelector string, year int) ([]DraftPick, erro
 		if len(rowData) > 0 {
 			tableData = append(tableData, rowData)
 			rowYear, err := strconv.Atoi(rowData[0])
-			if err == nil && rowYear == year {
+			if err == nil && rowYear >= from && rowYear <= to {
 				draft = append(draft, rowData)
+				playerIds = append(playerIds, row.Find("[data-stat=player]").AttrOr("data-append-csv", ""))
 			}
 		}
 	})
 
 	if len(draft) == 0 {
-		return []DraftPick{}, fmt.Errorf("no data found for year %d", year)
+		if from == to {
+			return []DraftPick{}, fmt.Errorf("no data found for year %d", from)
+		}
+		return []DraftPick{}, fmt.Errorf("no data found for years %d-%d", from, to)
 	}
 
 	resDraft := []DraftPick{}
 
 	for i := 0; i < len(draft); i++ {
-		fmt.Println(draft[i])
 		year, _ := strconv.Atoi(draft[i][0])
 		round, _ := strconv.Atoi(draft[i][1])
-		name := draft[i][2]
+		name := cleanName(strings.TrimSuffix(draft[i][2], "HOF"))
 		pick, _ := strconv.Atoi(draft[i][3])
 		position := draft[i][4]
 		lastSeason, _ := strconv.Atoi(draft[i][5])
@@ -157,6 +224,8 @@ func GetDraftYear(url string, tableSelector string, year int) ([]DraftPick, erro
 			Year:          year,
 			Round:         round,
 			Name:          name,
+			PlayerId:      playerIds[i],
+			HallOfFame:    strings.HasSuffix(draft[i][2], "HOF"),
 			Pick:          pick,
 			Position:      position,
 			LastSeason:    lastSeason,
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type InjuryReport struct {
+	PlayerId   string `json:"playerId"`
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	Week       string `json:"week"`
+	BoxscoreId string `json:"boxscoreId"`
+	Status     string `json:"status"`
+	Injury     string `json:"injury"`
+	Played     bool   `json:"played"`
+}
+
+// Report abbreviations used as cell text when there's no tooltip
+var injuryStatuses = map[string]string{
+	"O":   "Out",
+	"D":   "Doubtful",
+	"Q":   "Questionable",
+	"P":   "Probable",
+	"IR":  "Injured Reserve",
+	"PUP": "Physically Unable to Perform",
+}
+
+/*
+Gets weekly injury report entries for a team's season, one per player per week listed
+PlayerId matches RosterPlayer.PlayerId and BoxscoreId matches Game.BoxscoreId, so reports join to /team/roster and /season/games
+*/
+func GetTeamInjuries(url string, tableId string, team string, year int) ([]InjuryReport, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []InjuryReport{}, err
+	}
+
+	table := findTable(doc, tableId)
+
+	// Week columns head with the game ("9/7 vs DET") linking to its boxscore
+	type weekColumn struct {
+		week       string
+		boxscoreId string
+	}
+	weeks := map[string]weekColumn{}
+	table.Find("thead th").Each(func(i int, th *goquery.Selection) {
+		stat := th.AttrOr("data-stat", "")
+		if !strings.HasPrefix(stat, "week_") {
+			return
+		}
+		weeks[stat] = weekColumn{
+			week:       strings.TrimPrefix(stat, "week_"),
+			boxscoreId: idFromHref(th.Find("a").AttrOr("href", "")),
+		}
+	})
+
+	injuries := []InjuryReport{}
+	table.Find("tbody tr").Each(func(i int, tr *goquery.Selection) {
+		if tr.HasClass("thead") {
+			return
+		}
+
+		playerCell := tr.Find("[data-stat=player]")
+		playerId := playerCell.AttrOr("data-append-csv", "")
+		if playerId == "" {
+			playerId = idFromHref(playerCell.Find("a").AttrOr("href", ""))
+		}
+		position := strings.TrimSpace(tr.Find("[data-stat=pos]").Text())
+
+		tr.Find("td").Each(func(j int, cell *goquery.Selection) {
+			column, exists := weeks[cell.AttrOr("data-stat", "")]
+			if !exists {
+				return
+			}
+
+			// Tooltip reads "Questionable: Ankle", the cell holds the abbreviation
+			status, injury, _ := strings.Cut(cell.AttrOr("data-tip", ""), ":")
+			abbreviation := strings.TrimSpace(cell.Text())
+			if status == "" {
+				status = injuryStatuses[abbreviation]
+			}
+			if status == "" {
+				status = abbreviation
+			}
+			if status == "" {
+				return
+			}
+
+			injuries = append(injuries, InjuryReport{
+				PlayerId:   playerId,
+				Name:       cleanName(playerCell.Text()),
+				Position:   position,
+				Week:       column.week,
+				BoxscoreId: column.boxscoreId,
+				Status:     strings.TrimSpace(status),
+				Injury:     strings.TrimSpace(injury),
+				Played:     !cell.HasClass("dnp"),
+			})
+		})
+	})
+
+	if len(injuries) == 0 {
+		return []InjuryReport{}, fmt.Errorf("no injury reports found for %s %d", team, year)
+	}
+
+	return injuries, nil
+}
@@ -0,0 +1,415 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Columns shared by every per-player table
+type PlayerSeasonInfo struct {
+	PlayerId     string `json:"playerId"`
+	Name         string `json:"name"`
+	Team         string `json:"team,omitempty"`
+	Age          int    `json:"age"`
+	Position     string `json:"position"`
+	GamesPlayed  int    `json:"gamesPlayed"`
+	GamesStarted int    `json:"gamesStarted"`
+	AV           int    `json:"av"`
+}
+
+type PassingStats struct {
+	PlayerSeasonInfo
+	QBRecord           string  `json:"qbRecord"`
+	Completions        int     `json:"completions"`
+	Attempts           int     `json:"attempts"`
+	CompletionPerc     float64 `json:"completionPerc"`
+	Yards              int     `json:"yards"`
+	TDs                int     `json:"tds"`
+	TDPerc             float64 `json:"tdPerc"`
+	Ints               int     `json:"ints"`
+	IntPerc            float64 `json:"intPerc"`
+	FirstDowns         int     `json:"firstDowns"`
+	SuccessPerc        float64 `json:"successPerc"`
+	Long               int     `json:"long"`
+	YardsPerAtt        float64 `json:"yardsPerAtt"`
+	AdjYardsPerAtt     float64 `json:"adjYardsPerAtt"`
+	YardsPerCmp        float64 `json:"yardsPerCmp"`
+	YardsPerGame       float64 `json:"yardsPerGame"`
+	Rating             float64 `json:"rating"`
+	QBR                float64 `json:"qbr"`
+	Sacks              int     `json:"sacks"`
+	SackYards          int     `json:"sackYards"`
+	SackPerc           float64 `json:"sackPerc"`
+	NetYardsPerAtt     float64 `json:"netYardsPerAtt"`
+	AdjNetYardsPerAtt  float64 `json:"adjNetYardsPerAtt"`
+	FourthQtrComebacks int     `json:"fourthQtrComebacks"`
+	GameWinningDrives  int     `json:"gameWinningDrives"`
+}
+
+type RushingReceivingStats struct {
+	PlayerSeasonInfo
+	RushAttempts        int     `json:"rushAttempts"`
+	RushYards           int     `json:"rushYards"`
+	RushTDs             int     `json:"rushTds"`
+	RushFirstDowns      int     `json:"rushFirstDowns"`
+	RushLong            int     `json:"rushLong"`
+	RushYardsPerAtt     float64 `json:"rushYardsPerAtt"`
+	RushYardsPerGame    float64 `json:"rushYardsPerGame"`
+	Targets             int     `json:"targets"`
+	Receptions          int     `json:"receptions"`
+	ReceivingYards      int     `json:"receivingYards"`
+	YardsPerReception   float64 `json:"yardsPerReception"`
+	ReceivingTDs        int     `json:"receivingTds"`
+	ReceivingFirstDowns int     `json:"receivingFirstDowns"`
+	ReceivingLong       int     `json:"receivingLong"`
+	YardsPerTarget      float64 `json:"yardsPerTarget"`
+	CatchPerc           float64 `json:"catchPerc"`
+	Touches             int     `json:"touches"`
+	YardsFromScrimmage  int     `json:"yardsFromScrimmage"`
+	TotalTDs            int     `json:"totalTds"`
+	Fumbles             int     `json:"fumbles"`
+}
+
+type DefenseStats struct {
+	PlayerSeasonInfo
+	Ints             int     `json:"ints"`
+	IntYards         int     `json:"intYards"`
+	IntTDs           int     `json:"intTds"`
+	IntLong          int     `json:"intLong"`
+	PassesDefended   int     `json:"passesDefended"`
+	ForcedFumbles    int     `json:"forcedFumbles"`
+	Fumbles          int     `json:"fumbles"`
+	FumblesRecovered int     `json:"fumblesRecovered"`
+	FumbleYards      int     `json:"fumbleYards"`
+	FumbleTDs        int     `json:"fumbleTds"`
+	Sacks            float64 `json:"sacks"`
+	CombinedTackles  int     `json:"combinedTackles"`
+	SoloTackles      int     `json:"soloTackles"`
+	AssistedTackles  int     `json:"assistedTackles"`
+	TacklesForLoss   int     `json:"tacklesForLoss"`
+	QBHits           int     `json:"qbHits"`
+	Safeties         int     `json:"safeties"`
+}
+
+type KickingStats struct {
+	PlayerSeasonInfo
+	FGAttempts      int     `json:"fgAttempts"`
+	FGMade          int     `json:"fgMade"`
+	FGLong          int     `json:"fgLong"`
+	FGPerc          float64 `json:"fgPerc"`
+	XPAttempts      int     `json:"xpAttempts"`
+	XPMade          int     `json:"xpMade"`
+	XPPerc          float64 `json:"xpPerc"`
+	Kickoffs        int     `json:"kickoffs"`
+	KickoffYards    int     `json:"kickoffYards"`
+	Touchbacks      int     `json:"touchbacks"`
+	TouchbackPerc   float64 `json:"touchbackPerc"`
+	KickoffYardsAvg float64 `json:"kickoffYardsAvg"`
+}
+
+type PuntingStats struct {
+	PlayerSeasonInfo
+	Punts           int     `json:"punts"`
+	Yards           int     `json:"yards"`
+	Long            int     `json:"long"`
+	Blocked         int     `json:"blocked"`
+	YardsPerPunt    float64 `json:"yardsPerPunt"`
+	ReturnYards     int     `json:"returnYards"`
+	NetYards        int     `json:"netYards"`
+	NetYardsPerPunt float64 `json:"netYardsPerPunt"`
+	Touchbacks      int     `json:"touchbacks"`
+	TouchbackPerc   float64 `json:"touchbackPerc"`
+	Inside20        int     `json:"inside20"`
+	Inside20Perc    float64 `json:"inside20Perc"`
+}
+
+type ReturnStats struct {
+	PlayerSeasonInfo
+	PuntReturns        int     `json:"puntReturns"`
+	PuntReturnYards    int     `json:"puntReturnYards"`
+	PuntReturnTDs      int     `json:"puntReturnTds"`
+	PuntReturnLong     int     `json:"puntReturnLong"`
+	YardsPerPuntReturn float64 `json:"yardsPerPuntReturn"`
+	KickReturns        int     `json:"kickReturns"`
+	KickReturnYards    int     `json:"kickReturnYards"`
+	KickReturnTDs      int     `json:"kickReturnTds"`
+	KickReturnLong     int     `json:"kickReturnLong"`
+	YardsPerKickReturn float64 `json:"yardsPerKickReturn"`
+	AllPurposeYards    int     `json:"allPurposeYards"`
+}
+
+type ScoringStats struct {
+	PlayerSeasonInfo
+	RushTDs          int     `json:"rushTds"`
+	ReceivingTDs     int     `json:"receivingTds"`
+	PuntReturnTDs    int     `json:"puntReturnTds"`
+	KickReturnTDs    int     `json:"kickReturnTds"`
+	FumbleTDs        int     `json:"fumbleTds"`
+	IntTDs           int     `json:"intTds"`
+	OtherTDs         int     `json:"otherTds"`
+	TotalTDs         int     `json:"totalTds"`
+	TwoPointMade     int     `json:"twoPointMade"`
+	TwoPointAttempts int     `json:"twoPointAttempts"`
+	XPMade           int     `json:"xpMade"`
+	XPAttempts       int     `json:"xpAttempts"`
+	FGMade           int     `json:"fgMade"`
+	FGAttempts       int     `json:"fgAttempts"`
+	Safeties         int     `json:"safeties"`
+	Points           int     `json:"points"`
+	PointsPerGame    float64 `json:"pointsPerGame"`
+}
+
+// Maps route categories to PFR table ids
+var playerStatCategories = map[string]string{
+	"passing":               "passing",
+	"rushing_and_receiving": "rushing_and_receiving",
+	"rushing":               "rushing_and_receiving",
+	"receiving":             "rushing_and_receiving",
+	"defense":               "defense",
+	"kicking":               "kicking",
+	"punting":               "punting",
+	"returns":               "returns",
+	"scoring":               "scoring",
+}
+
+func GetTeamPlayerStats(url string, category string) (any, error) {
+	tableId, exists := playerStatCategories[category]
+	if !exists {
+		return nil, fmt.Errorf("unknown category %s", category)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := readTableRows(findTable(doc, tableId))
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no %s data found for selected year", category)
+	}
+
+	return parsePlayerStatRows(tableId, rows), nil
+}
+
+// Converts rows of a per-player table into the typed rows for its category
+func parsePlayerStatRows(tableId string, rows []tableRow) any {
+	switch tableId {
+	case "passing":
+		res := []PassingStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, PassingStats{
+					PlayerSeasonInfo:   info,
+					QBRecord:           row["qb_rec"],
+					Completions:        row.intStat("pass_cmp"),
+					Attempts:           row.intStat("pass_att"),
+					CompletionPerc:     row.floatStat("pass_cmp_perc", "pass_cmp_pct") / 100,
+					Yards:              row.intStat("pass_yds"),
+					TDs:                row.intStat("pass_td"),
+					TDPerc:             row.floatStat("pass_td_perc", "pass_td_pct") / 100,
+					Ints:               row.intStat("pass_int"),
+					IntPerc:            row.floatStat("pass_int_perc", "pass_int_pct") / 100,
+					FirstDowns:         row.intStat("pass_first_down"),
+					SuccessPerc:        row.floatStat("pass_success") / 100,
+					Long:               row.intStat("pass_long"),
+					YardsPerAtt:        row.floatStat("pass_yds_per_att"),
+					AdjYardsPerAtt:     row.floatStat("pass_adj_yds_per_att"),
+					YardsPerCmp:        row.floatStat("pass_yds_per_cmp"),
+					YardsPerGame:       row.floatStat("pass_yds_per_g"),
+					Rating:             row.floatStat("pass_rating"),
+					QBR:                row.floatStat("qbr"),
+					Sacks:              row.intStat("pass_sacked"),
+					SackYards:          row.intStat("pass_sacked_yds"),
+					SackPerc:           row.floatStat("pass_sacked_perc", "pass_sacked_pct") / 100,
+					NetYardsPerAtt:     row.floatStat("pass_net_yds_per_att"),
+					AdjNetYardsPerAtt:  row.floatStat("pass_adj_net_yds_per_att"),
+					FourthQtrComebacks: row.intStat("comebacks"),
+					GameWinningDrives:  row.intStat("gwd"),
+				})
+			}
+		}
+		return res
+	case "rushing_and_receiving":
+		res := []RushingReceivingStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, RushingReceivingStats{
+					PlayerSeasonInfo:    info,
+					RushAttempts:        row.intStat("rush_att"),
+					RushYards:           row.intStat("rush_yds"),
+					RushTDs:             row.intStat("rush_td"),
+					RushFirstDowns:      row.intStat("rush_first_down"),
+					RushLong:            row.intStat("rush_long"),
+					RushYardsPerAtt:     row.floatStat("rush_yds_per_att"),
+					RushYardsPerGame:    row.floatStat("rush_yds_per_g"),
+					Targets:             row.intStat("targets"),
+					Receptions:          row.intStat("rec"),
+					ReceivingYards:      row.intStat("rec_yds"),
+					YardsPerReception:   row.floatStat("rec_yds_per_rec"),
+					ReceivingTDs:        row.intStat("rec_td"),
+					ReceivingFirstDowns: row.intStat("rec_first_down"),
+					ReceivingLong:       row.intStat("rec_long"),
+					YardsPerTarget:      row.floatStat("rec_yds_per_tgt"),
+					CatchPerc:           row.floatStat("catch_pct") / 100,
+					Touches:             row.intStat("touches"),
+					YardsFromScrimmage:  row.intStat("yds_from_scrimmage"),
+					TotalTDs:            row.intStat("rush_receive_td"),
+					Fumbles:             row.intStat("fumbles"),
+				})
+			}
+		}
+		return res
+	case "defense":
+		res := []DefenseStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, DefenseStats{
+					PlayerSeasonInfo: info,
+					Ints:             row.intStat("def_int"),
+					IntYards:         row.intStat("def_int_yds"),
+					IntTDs:           row.intStat("def_int_td"),
+					IntLong:          row.intStat("def_int_long"),
+					PassesDefended:   row.intStat("pass_defended"),
+					ForcedFumbles:    row.intStat("fumbles_forced"),
+					Fumbles:          row.intStat("fumbles"),
+					FumblesRecovered: row.intStat("fumbles_rec"),
+					FumbleYards:      row.intStat("fumbles_rec_yds"),
+					FumbleTDs:        row.intStat("fumbles_rec_td"),
+					Sacks:            row.floatStat("sacks"),
+					CombinedTackles:  row.intStat("tackles_combined"),
+					SoloTackles:      row.intStat("tackles_solo"),
+					AssistedTackles:  row.intStat("tackles_assists"),
+					TacklesForLoss:   row.intStat("tackles_loss"),
+					QBHits:           row.intStat("qb_hits"),
+					Safeties:         row.intStat("safety_md"),
+				})
+			}
+		}
+		return res
+	case "kicking":
+		res := []KickingStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, KickingStats{
+					PlayerSeasonInfo: info,
+					FGAttempts:       row.intStat("fga"),
+					FGMade:           row.intStat("fgm"),
+					FGLong:           row.intStat("fg_long"),
+					FGPerc:           row.floatStat("fg_perc", "fg_pct") / 100,
+					XPAttempts:       row.intStat("xpa"),
+					XPMade:           row.intStat("xpm"),
+					XPPerc:           row.floatStat("xp_perc", "xp_pct") / 100,
+					Kickoffs:         row.intStat("kickoff"),
+					KickoffYards:     row.intStat("kickoff_yds"),
+					Touchbacks:       row.intStat("kickoff_tb"),
+					TouchbackPerc:    row.floatStat("kickoff_tb_pct") / 100,
+					KickoffYardsAvg:  row.floatStat("kickoff_yds_avg"),
+				})
+			}
+		}
+		return res
+	case "punting":
+		res := []PuntingStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, PuntingStats{
+					PlayerSeasonInfo: info,
+					Punts:            row.intStat("punt"),
+					Yards:            row.intStat("punt_yds"),
+					Long:             row.intStat("punt_long"),
+					Blocked:          row.intStat("punt_blocked"),
+					YardsPerPunt:     row.floatStat("punt_yds_per_punt"),
+					ReturnYards:      row.intStat("punt_ret_yds_opp"),
+					NetYards:         row.intStat("punt_net_yds"),
+					NetYardsPerPunt:  row.floatStat("punt_net_yds_per_punt"),
+					Touchbacks:       row.intStat("punt_tb"),
+					TouchbackPerc:    row.floatStat("punt_tb_pct") / 100,
+					Inside20:         row.intStat("punt_in_20"),
+					Inside20Perc:     row.floatStat("punt_in_20_pct") / 100,
+				})
+			}
+		}
+		return res
+	case "returns":
+		res := []ReturnStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, ReturnStats{
+					PlayerSeasonInfo:   info,
+					PuntReturns:        row.intStat("punt_ret"),
+					PuntReturnYards:    row.intStat("punt_ret_yds"),
+					PuntReturnTDs:      row.intStat("punt_ret_td"),
+					PuntReturnLong:     row.intStat("punt_ret_long"),
+					YardsPerPuntReturn: row.floatStat("punt_ret_yds_per_ret"),
+					KickReturns:        row.intStat("kick_ret"),
+					KickReturnYards:    row.intStat("kick_ret_yds"),
+					KickReturnTDs:      row.intStat("kick_ret_td"),
+					KickReturnLong:     row.intStat("kick_ret_long"),
+					YardsPerKickReturn: row.floatStat("kick_ret_yds_per_ret"),
+					AllPurposeYards:    row.intStat("all_purpose_yds"),
+				})
+			}
+		}
+		return res
+	case "scoring":
+		res := []ScoringStats{}
+		for _, row := range rows {
+			if info, ok := parsePlayerSeasonInfo(row); ok {
+				res = append(res, ScoringStats{
+					PlayerSeasonInfo: info,
+					RushTDs:          row.intStat("rushtd"),
+					ReceivingTDs:     row.intStat("rectd"),
+					PuntReturnTDs:    row.intStat("prtd"),
+					KickReturnTDs:    row.intStat("krtd"),
+					FumbleTDs:        row.intStat("frtd"),
+					IntTDs:           row.intStat("ydtd", "def_int_td"),
+					OtherTDs:         row.intStat("otd"),
+					TotalTDs:         row.intStat("alltd"),
+					TwoPointMade:     row.intStat("two_pt_md"),
+					TwoPointAttempts: row.intStat("two_pt_att"),
+					XPMade:           row.intStat("xpm"),
+					XPAttempts:       row.intStat("xpa"),
+					FGMade:           row.intStat("fgm"),
+					FGAttempts:       row.intStat("fga"),
+					Safeties:         row.intStat("safety_md"),
+					Points:           row.intStat("scoring"),
+					PointsPerGame:    row.floatStat("scoring_per_g"),
+				})
+			}
+		}
+		return res
+	}
+
+	return nil
+}
+
+// Reads the columns every per-player table shares, false for total and league average rows
+func parsePlayerSeasonInfo(row tableRow) (PlayerSeasonInfo, bool) {
+	name := row.first("player", "name_display")
+	if name == "" || strings.HasSuffix(name, "Total") || strings.HasPrefix(name, "League") {
+		return PlayerSeasonInfo{}, false
+	}
+
+	playerId := row.first("player_id", "name_display_id")
+	if playerId == "" {
+		playerId = idFromHref(row.first("player_href", "name_display_href"))
+	}
+
+	team := row.first("team", "team_name_abbr")
+	if teamHref := row.first("team_href", "team_name_abbr_href"); teamHref != "" {
+		team = teamFromHref(teamHref)
+	}
+
+	info := PlayerSeasonInfo{
+		PlayerId:     playerId,
+		Name:         cleanName(name),
+		Team:         team,
+		Age:          row.intStat("age"),
+		Position:     row.first("pos"),
+		GamesPlayed:  row.intStat("g", "games"),
+		GamesStarted: row.intStat("gs", "games_started"),
+		AV:           row.intStat("av"),
+	}
+
+	return info, true
+}
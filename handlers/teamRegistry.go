@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"sort"
+)
+
+// Registry of active franchises by PFR code. PFR keeps one code per franchise across relocations and
+// renames (e.g. "oti" covers the Houston Oilers, Tennessee Oilers and Tennessee Titans), so seasons
+// for any era can be requested with the codes in teams.txt.
+
+type FranchiseName struct {
+	Name string `json:"name"`
+	From int    `json:"from"`
+	To   int    `json:"to"` // 0 for current name
+}
+
+type Franchise struct {
+	Code        string          `json:"code"`
+	Name        string          `json:"name"`
+	Conference  string          `json:"conference"`
+	Division    string          `json:"division"`
+	FirstSeason int             `json:"firstSeason"`
+	History     []FranchiseName `json:"history"`
+}
+
+var franchises = map[string]Franchise{
+	"crd": {"crd", "Arizona Cardinals", "NFC", "West", 1920, []FranchiseName{{"Chicago Cardinals", 1920, 1959}, {"St. Louis Cardinals", 1960, 1987}, {"Phoenix Cardinals", 1988, 1993}, {"Arizona Cardinals", 1994, 0}}},
+	"atl": {"atl", "Atlanta Falcons", "NFC", "South", 1966, []FranchiseName{{"Atlanta Falcons", 1966, 0}}},
+	"rav": {"rav", "Baltimore Ravens", "AFC", "North", 1996, []FranchiseName{{"Baltimore Ravens", 1996, 0}}},
+	"buf": {"buf", "Buffalo Bills", "AFC", "East", 1960, []FranchiseName{{"Buffalo Bills", 1960, 0}}},
+	"car": {"car", "Carolina Panthers", "NFC", "South", 1995, []FranchiseName{{"Carolina Panthers", 1995, 0}}},
+	"chi": {"chi", "Chicago Bears", "NFC", "North", 1920, []FranchiseName{{"Decatur Staleys", 1920, 1920}, {"Chicago Staleys", 1921, 1921}, {"Chicago Bears", 1922, 0}}},
+	"cin": {"cin", "Cincinnati Bengals", "AFC", "North", 1968, []FranchiseName{{"Cincinnati Bengals", 1968, 0}}},
+	"cle": {"cle", "Cleveland Browns", "AFC", "North", 1946, []FranchiseName{{"Cleveland Browns", 1946, 0}}},
+	"dal": {"dal", "Dallas Cowboys", "NFC", "East", 1960, []FranchiseName{{"Dallas Cowboys", 1960, 0}}},
+	"den": {"den", "Denver Broncos", "AFC", "West", 1960, []FranchiseName{{"Denver Broncos", 1960, 0}}},
+	"det": {"det", "Detroit Lions", "NFC", "North", 1930, []FranchiseName{{"Portsmouth Spartans", 1930, 1933}, {"Detroit Lions", 1934, 0}}},
+	"gnb": {"gnb", "Green Bay Packers", "NFC", "North", 1921, []FranchiseName{{"Green Bay Packers", 1921, 0}}},
+	"htx": {"htx", "Houston Texans", "AFC", "South", 2002, []FranchiseName{{"Houston Texans", 2002, 0}}},
+	"clt": {"clt", "Indianapolis Colts", "AFC", "South", 1953, []FranchiseName{{"Baltimore Colts", 1953, 1983}, {"Indianapolis Colts", 1984, 0}}},
+	"jax": {"jax", "Jacksonville Jaguars", "AFC", "South", 1995, []FranchiseName{{"Jacksonville Jaguars", 1995, 0}}},
+	"kan": {"kan", "Kansas City Chiefs", "AFC", "West", 1960, []FranchiseName{{"Dallas Texans", 1960, 1962}, {"Kansas City Chiefs", 1963, 0}}},
+	"rai": {"rai", "Las Vegas Raiders", "AFC", "West", 1960, []FranchiseName{{"Oakland Raiders", 1960, 1981}, {"Los Angeles Raiders", 1982, 1994}, {"Oakland Raiders", 1995, 2019}, {"Las Vegas Raiders", 2020, 0}}},
+	"sdg": {"sdg", "Los Angeles Chargers", "AFC", "West", 1960, []FranchiseName{{"Los Angeles Chargers", 1960, 1960}, {"San Diego Chargers", 1961, 2016}, {"Los Angeles Chargers", 2017, 0}}},
+	"ram": {"ram", "Los Angeles Rams", "NFC", "West", 1937, []FranchiseName{{"Cleveland Rams", 1937, 1945}, {"Los Angeles Rams", 1946, 1994}, {"St. Louis Rams", 1995, 2015}, {"Los Angeles Rams", 2016, 0}}},
+	"mia": {"mia", "Miami Dolphins", "AFC", "East", 1966, []FranchiseName{{"Miami Dolphins", 1966, 0}}},
+	"min": {"min", "Minnesota Vikings", "NFC", "North", 1961, []FranchiseName{{"Minnesota Vikings", 1961, 0}}},
+	"nwe": {"nwe", "New England Patriots", "AFC", "East", 1960, []FranchiseName{{"Boston Patriots", 1960, 1970}, {"New England Patriots", 1971, 0}}},
+	"nor": {"nor", "New Orleans Saints", "NFC", "South", 1967, []FranchiseName{{"New Orleans Saints", 1967, 0}}},
+	"nyg": {"nyg", "New York Giants", "NFC", "East", 1925, []FranchiseName{{"New York Giants", 1925, 0}}},
+	"nyj": {"nyj", "New York Jets", "AFC", "East", 1960, []FranchiseName{{"New York Titans", 1960, 1962}, {"New York Jets", 1963, 0}}},
+	"phi": {"phi", "Philadelphia Eagles", "NFC", "East", 1933, []FranchiseName{{"Philadelphia Eagles", 1933, 0}}},
+	"pit": {"pit", "Pittsburgh Steelers", "AFC", "North", 1933, []FranchiseName{{"Pittsburgh Pirates", 1933, 1939}, {"Pittsburgh Steelers", 1940, 0}}},
+	"sfo": {"sfo", "San Francisco 49ers", "NFC", "West", 1946, []FranchiseName{{"San Francisco 49ers", 1946, 0}}},
+	"sea": {"sea", "Seattle Seahawks", "NFC", "West", 1976, []FranchiseName{{"Seattle Seahawks", 1976, 0}}},
+	"tam": {"tam", "Tampa Bay Buccaneers", "NFC", "South", 1976, []FranchiseName{{"Tampa Bay Buccaneers", 1976, 0}}},
+	"oti": {"oti", "Tennessee Titans", "AFC", "South", 1960, []FranchiseName{{"Houston Oilers", 1960, 1996}, {"Tennessee Oilers", 1997, 1998}, {"Tennessee Titans", 1999, 0}}},
+	"was": {"was", "Washington Commanders", "NFC", "East", 1932, []FranchiseName{{"Boston Braves", 1932, 1932}, {"Boston Redskins", 1933, 1936}, {"Washington Redskins", 1937, 2019}, {"Washington Football Team", 2020, 2021}, {"Washington Commanders", 2022, 0}}},
+}
+
+// Looks up franchise by PFR code (gnb, dal, jax, etc.)
+func GetFranchise(code string) (Franchise, bool) {
+	franchise, exists := franchises[code]
+	return franchise, exists
+}
+
+// Returns every registered franchise sorted by code
+func GetFranchises() []Franchise {
+	var res []Franchise
+	for _, franchise := range franchises {
+		res = append(res, franchise)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Code < res[j].Code
+	})
+	return res
+}
+
+// Name the franchise played under in a given season, empty if the franchise did not exist yet
+func (f Franchise) NameInYear(year int) string {
+	for _, name := range f.History {
+		if year >= name.From && (name.To == 0 || year <= name.To) {
+			return name.Name
+		}
+	}
+	return ""
+}
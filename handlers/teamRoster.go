@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type RosterPlayer struct {
+	UniformNumber int    `json:"uniformNumber"`
+	PlayerId      string `json:"playerId"`
+	Name          string `json:"name"`
+	Age           int    `json:"age"`
+	Position      string `json:"position"`
+	GamesPlayed   int    `json:"gamesPlayed"`
+	GamesStarted  int    `json:"gamesStarted"`
+	Weight        int    `json:"weight"`
+	Height        string `json:"height"`
+	College       string `json:"college"`
+	BirthDate     string `json:"birthDate"`
+	Experience    int    `json:"experience"`
+	AV            int    `json:"av"`
+	DraftTeam     string `json:"draftTeam"`
+	DraftRound    int    `json:"draftRound"`
+	DraftPick     int    `json:"draftPick"`
+	DraftYear     int    `json:"draftYear"`
+}
+
+func GetTeamRoster(url string, tableId string) ([]RosterPlayer, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []RosterPlayer{}, err
+	}
+
+	// Roster table is commented out on the page
+	rows := readTableRows(findTable(doc, tableId))
+
+	roster := []RosterPlayer{}
+	for _, row := range rows {
+		if row["player"] == "" {
+			continue
+		}
+
+		uniformNumber, _ := strconv.Atoi(row["uniform_number"])
+		age, _ := strconv.Atoi(row["age"])
+		gamesPlayed, _ := strconv.Atoi(row.first("g", "games"))
+		gamesStarted, _ := strconv.Atoi(row.first("gs", "games_started"))
+		weight, _ := strconv.Atoi(row["weight"])
+		// "Rook" is left as 0 years
+		experience, _ := strconv.Atoi(row["experience"])
+		av, _ := strconv.Atoi(row["av"])
+		draftTeam, draftRound, draftPick, draftYear := parseDraftInfo(row["draft_info"])
+
+		playerId := row["player_id"]
+		if playerId == "" {
+			playerId = idFromHref(row["player_href"])
+		}
+
+		player := RosterPlayer{
+			UniformNumber: uniformNumber,
+			PlayerId:      playerId,
+			Name:          row.name("player"),
+			Age:           age,
+			Position:      row["pos"],
+			GamesPlayed:   gamesPlayed,
+			GamesStarted:  gamesStarted,
+			Weight:        weight,
+			Height:        row["height"],
+			College:       row.first("college_id", "college"),
+			BirthDate:     row["birth_date_mod"],
+			Experience:    experience,
+			AV:            av,
+			DraftTeam:     draftTeam,
+			DraftRound:    draftRound,
+			DraftPick:     draftPick,
+			DraftYear:     draftYear,
+		}
+
+		roster = append(roster, player)
+	}
+
+	if len(roster) == 0 {
+		return []RosterPlayer{}, fmt.Errorf("no data found for selected year")
+	}
+
+	return roster, nil
+}
+
+// "Atlanta Falcons / 1st / 3rd pick / 2007" -> ("Atlanta Falcons", 1, 3, 2007), undrafted players return zero values
+func parseDraftInfo(draftInfo string) (string, int, int, int) {
+	parts := strings.Split(draftInfo, "/")
+	if len(parts) < 4 {
+		return "", 0, 0, 0
+	}
+
+	trimOrdinal := func(s string) int {
+		s = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "pick"))
+		value, _ := strconv.Atoi(strings.TrimRight(s, "stndrh"))
+		return value
+	}
+
+	team := strings.TrimSpace(parts[0])
+	round := trimOrdinal(parts[1])
+	pick := trimOrdinal(parts[2])
+	year, _ := strconv.Atoi(strings.TrimSpace(parts[3]))
+
+	return team, round, pick, year
+}
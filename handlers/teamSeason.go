@@ -11,38 +11,42 @@ import (
 )
 
 type SeasonOverlook struct {
-	Year               int     `json:"year"`
-	League             string  `json:"league"`
-	Team               string  `json:"team"`
-	Wins               int     `json:"wins"`
-	Losses             int     `json:"losses"`
-	Ties               int     `json:"ties"`
-	DivisionFinish     int     `json:"divisionFinish"`
-	PlayoffExitRound   int     `json:"playoffExitRound"`
-	PointsFor          int     `json:"pointsFor"`
-	PointsAgainst      int     `json:"pointsAgainst"`
-	PointsDif          int     `json:"pointsDif"`
-	HeadCoaches        string  `json:"headCoaches"`
-	BestPlayerAv       string  `json:"bestPlayerAv"`
-	BestPlayerPasser   string  `json:"bestPlayerPasser"`
-	BestPlayerRusher   string  `json:"bestPlayerRusher"`
-	BestPlayerReceiver string  `json:"bestPlayerReceiver"`
-	OffRankPts         int     `json:"offRankPts"`
-	OffRankYds         int     `json:"offRankYds"`
-	DefRankPts         int     `json:"defRankPts"`
-	DefRankYds         int     `json:"defRankYds"`
-	TakeawayRank       int     `json:"takeawayRank"`
-	PointsDifRank      int     `json:"pointsDifRank"`
-	YardsDifRank       int     `json:"yardsDifRank"`
-	TeamsInLeague      int     `json:"teamsInLeague"`
-	MarginOfVictory    float64 `json:"marginOfVictory"`
-	StrengthOfSchedule float64 `json:"strengthOfSchedule"`
-	Srs                float64 `json:"srs"`
-	OffensiveSrs       float64 `json:"offensiveSrs"`
-	DefensiveSrs       float64 `json:"defensiveSrs"`
+	Year               int        `json:"year"`
+	League             string     `json:"league"`
+	Team               string     `json:"team"`
+	Wins               int        `json:"wins"`
+	Losses             int        `json:"losses"`
+	Ties               int        `json:"ties"`
+	DivisionFinish     int        `json:"divisionFinish"`
+	PlayoffExitRound   int        `json:"playoffExitRound"`
+	PointsFor          int        `json:"pointsFor"`
+	PointsAgainst      int        `json:"pointsAgainst"`
+	PointsDif          int        `json:"pointsDif"`
+	HeadCoaches        []CoachRef `json:"headCoaches"`
+	BestPlayerAv       string     `json:"bestPlayerAv"`
+	BestPlayerPasser   string     `json:"bestPlayerPasser"`
+	BestPlayerRusher   string     `json:"bestPlayerRusher"`
+	BestPlayerReceiver string     `json:"bestPlayerReceiver"`
+	OffRankPts         int        `json:"offRankPts"`
+	OffRankYds         int        `json:"offRankYds"`
+	DefRankPts         int        `json:"defRankPts"`
+	DefRankYds         int        `json:"defRankYds"`
+	TakeawayRank       int        `json:"takeawayRank"`
+	PointsDifRank      int        `json:"pointsDifRank"`
+	YardsDifRank       int        `json:"yardsDifRank"`
+	TeamsInLeague      int        `json:"teamsInLeague"`
+	MarginOfVictory    float64    `json:"marginOfVictory"`
+	StrengthOfSchedule float64    `json:"strengthOfSchedule"`
+	Srs                float64    `json:"srs"`
+	OffensiveSrs       float64    `json:"offensiveSrs"`
+	DefensiveSrs       float64    `json:"defensiveSrs"`
 }
 
-func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlook, error) {
+/*
+Gets a team's season summary, titleGames maps a league (NFL, AFL) to the title games that decided its champion, see GetChampionships
+Seasons titleGames has no game for fall back to PFR's "Won SB" / "Won Champ" playoff text
+*/
+func GetSeasonOverlook(url string, tableSelector string, team string, year int, titleGames map[string][]Championship) (SeasonOverlook, error) {
 	client := &http.Client{
 		Timeout: 4 * (time.Second + 8),
 	}
@@ -105,6 +109,7 @@ func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlo
 
 	var tableData [][]string
 	var season []string
+	var headCoaches []CoachRef
 	doc.Find(tableSelector).Find("tr").Each(func(i int, row *goquery.Selection) {
 		var rowData []string
 		row.Find("td, th").Each(func(j int, cell *goquery.Selection) {
@@ -115,6 +120,8 @@ func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlo
 			rowYear, err := strconv.Atoi(rowData[0])
 			if err == nil && rowYear == year {
 				season = rowData
+				// Coaches column keeps its links so ids survive mid-season changes ("Smith, Jones")
+				headCoaches = parseCoachRefs(row.Find("td, th").Eq(11))
 			}
 		}
 	})
@@ -124,7 +131,7 @@ func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlo
 	}
 
 	league := season[1]
-	team := season[2]
+	teamName := season[2]
 	wins, _ := strconv.Atoi(season[3])
 	losses, _ := strconv.Atoi(season[4])
 	ties, _ := strconv.Atoi(season[5])
@@ -140,14 +147,19 @@ func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlo
 		playoffExitRoundInt = 3
 	} else if playoffExitRoundString == "Lost SB" || playoffExitRoundString == "Lost Champ" {
 		playoffExitRoundInt = 4
-	} else if playoffExitRoundString == "Won SB" || playoffExitRoundString == "Won Champ" {
+	}
+
+	won, covered := wonChampionship(titleGames[league], team, year)
+	if !covered {
+		won = playoffExitRoundString == "Won SB" || playoffExitRoundString == "Won Champ"
+	}
+	if won {
 		playoffExitRoundInt = 5
 	}
 
 	pointsFor, _ := strconv.Atoi(season[8])
 	pointsAgainst, _ := strconv.Atoi(season[9])
 	pointsDif, _ := strconv.Atoi(season[10])
-	headCoaches := season[11]
 	bestPlayerAv := season[12]
 	bestPlayerPasser := season[13]
 	bestPlayerRusher := season[14]
@@ -169,7 +181,7 @@ func GetSeasonOverlook(url string, tableSelector string, year int) (SeasonOverlo
 	seasonOverlook := SeasonOverlook{
 		Year:               year,
 		League:             league,
-		Team:               team,
+		Team:               teamName,
 		Wins:               wins,
 		Losses:             losses,
 		Ties:               ties,
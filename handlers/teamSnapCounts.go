@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const firstSnapCountYear = 2012
+
+type SnapCount struct {
+	PlayerId             string  `json:"playerId"`
+	Name                 string  `json:"name"`
+	Position             string  `json:"position"`
+	OffenseSnaps         int     `json:"offenseSnaps"`
+	OffenseSnapPerc      float64 `json:"offenseSnapPerc"`
+	DefenseSnaps         int     `json:"defenseSnaps"`
+	DefenseSnapPerc      float64 `json:"defenseSnapPerc"`
+	SpecialTeamsSnaps    int     `json:"specialTeamsSnaps"`
+	SpecialTeamsSnapPerc float64 `json:"specialTeamsSnapPerc"`
+}
+
+type TeamSnapCounts struct {
+	Team       string      `json:"team"`
+	Year       int         `json:"year"`
+	BoxscoreId string      `json:"boxscoreId,omitempty"` // set for a single game
+	Players    []SnapCount `json:"players"`
+}
+
+/*
+Gets offense, defense and special teams snaps per player for a team's season
+PFR tracks snap counts from 2012 on
+*/
+func GetTeamSnapCounts(url string, tableId string, team string, year int) (TeamSnapCounts, error) {
+	if year < firstSnapCountYear {
+		return TeamSnapCounts{}, fmt.Errorf("snap counts are only available from %d on", firstSnapCountYear)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamSnapCounts{}, err
+	}
+
+	players := parseSnapCountRows(readTableRows(findTable(doc, tableId)))
+	if len(players) == 0 {
+		return TeamSnapCounts{}, fmt.Errorf("no snap counts found for %s %d", team, year)
+	}
+
+	return TeamSnapCounts{Team: team, Year: year, Players: players}, nil
+}
+
+/*
+Gets snap counts for one game from its boxscore, which lists both teams
+The team's table is picked by which side of the scorebox links to the franchise
+*/
+func GetGameSnapCounts(url string, team string, year int, boxscoreId string) (TeamSnapCounts, error) {
+	if year < firstSnapCountYear {
+		return TeamSnapCounts{}, fmt.Errorf("snap counts are only available from %d on", firstSnapCountYear)
+	}
+
+	franchise, exists := GetFranchise(team)
+	if !exists {
+		return TeamSnapCounts{}, fmt.Errorf("unknown team %s", team)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamSnapCounts{}, err
+	}
+
+	// The scorebox lists the visitor then the home team. Captions use nicknames that don't always
+	// match the franchise name ("Washington Football Team"), so the side comes from the team links
+	tableId := ""
+	doc.Find(".scorebox strong a").Each(func(i int, link *goquery.Selection) {
+		if teamFromHref(link.AttrOr("href", "")) != franchise.Code {
+			return
+		}
+		tableId = "vis_snap_counts"
+		if i == 1 {
+			tableId = "home_snap_counts"
+		}
+	})
+
+	if tableId != "" {
+		players := parseSnapCountRows(readTableRows(findTable(doc, tableId)))
+		if len(players) > 0 {
+			return TeamSnapCounts{Team: team, Year: year, BoxscoreId: boxscoreId, Players: players}, nil
+		}
+	}
+
+	return TeamSnapCounts{}, fmt.Errorf("no snap counts found for %s in %s", team, boxscoreId)
+}
+
+func parseSnapCountRows(rows []tableRow) []SnapCount {
+	players := []SnapCount{}
+	for _, row := range rows {
+		name := row.first("player", "name_display")
+		if name == "" {
+			continue
+		}
+
+		playerId := row.first("player_id", "name_display_id")
+		if playerId == "" {
+			playerId = idFromHref(row.first("player_href", "name_display_href"))
+		}
+
+		players = append(players, SnapCount{
+			PlayerId:             playerId,
+			Name:                 cleanName(name),
+			Position:             row["pos"],
+			OffenseSnaps:         row.intStat("offense", "snap_counts_offense"),
+			OffenseSnapPerc:      row.floatStat("off_pct", "snap_counts_off_pct") / 100,
+			DefenseSnaps:         row.intStat("defense", "snap_counts_defense"),
+			DefenseSnapPerc:      row.floatStat("def_pct", "snap_counts_def_pct") / 100,
+			SpecialTeamsSnaps:    row.intStat("special_teams", "snap_counts_special_teams"),
+			SpecialTeamsSnapPerc: row.floatStat("st_pct", "snap_counts_st_pct") / 100,
+		})
+	}
+	return players
+}
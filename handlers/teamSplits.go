@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Split struct {
+	Category string             `json:"category"` // Place, Result, Quarter, Down, Yards To Go, Field Position, etc.
+	Value    string             `json:"value"`    // Home, Road, Win, Loss, 4th Qtr, 3rd, 7-9, etc.
+	Stats    map[string]float64 `json:"stats"`    // keyed by PFR data-stat, columns differ between tables
+}
+
+type TeamSplits struct {
+	Team        string  `json:"team"`
+	Year        int     `json:"year"`
+	Game        []Split `json:"game"`        // home/away, wins/losses, by month, etc.
+	Situational []Split `json:"situational"` // by quarter, down and distance, field position, etc.
+}
+
+/*
+Gets a team's game and situational splits, e.g. third and long or fourth quarter scoring
+Specify category to keep only splits in it (case insensitive), empty returns every split
+*/
+func GetTeamSplits(url string, gameTableId string, situationalTableId string, team string, year int, category string) (TeamSplits, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamSplits{}, err
+	}
+
+	splits := TeamSplits{
+		Team:        team,
+		Year:        year,
+		Game:        parseSplitRows(readTableRows(findTable(doc, gameTableId)), category),
+		Situational: parseSplitRows(readTableRows(findTable(doc, situationalTableId)), category),
+	}
+
+	if len(splits.Game) == 0 && len(splits.Situational) == 0 {
+		return TeamSplits{}, fmt.Errorf("no splits found for %s %d", team, year)
+	}
+
+	return splits, nil
+}
+
+func parseSplitRows(rows []tableRow, category string) []Split {
+	splits := []Split{}
+
+	// The category is only written on the first row of its group
+	currentCategory := ""
+	for _, row := range rows {
+		if row["split_id"] != "" {
+			currentCategory = row["split_id"]
+		}
+		if row["split_value"] == "" {
+			continue
+		}
+		if category != "" && !strings.EqualFold(currentCategory, category) {
+			continue
+		}
+
+		stats := map[string]float64{}
+		for stat, value := range row {
+			if stat == "split_id" || stat == "split_value" || strings.HasSuffix(stat, "_id") || strings.Contains(stat, "_href") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSuffix(strings.ReplaceAll(value, ",", ""), "%"), 64)
+			if err == nil {
+				stats[stat] = parsed
+			}
+		}
+
+		splits = append(splits, Split{
+			Category: currentCategory,
+			Value:    row["split_value"],
+			Stats:    stats,
+		})
+	}
+
+	return splits
+}
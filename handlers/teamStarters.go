@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type Starter struct {
+	Position     string `json:"position"`
+	PlayerId     string `json:"playerId"`
+	Name         string `json:"name"`
+	Age          int    `json:"age"`
+	GamesPlayed  int    `json:"gamesPlayed"`
+	GamesStarted int    `json:"gamesStarted"`
+	AV           int    `json:"av"`
+}
+
+type TeamStarters struct {
+	Team         string    `json:"team"`
+	Franchise    string    `json:"franchise"`
+	Year         int       `json:"year"`
+	Offense      []Starter `json:"offense"`
+	Defense      []Starter `json:"defense"`
+	SpecialTeams []Starter `json:"specialTeams"`
+}
+
+// Includes the labels older seasons use (SE/FL receivers, TB/WB/BB backs, OT/OG linemen)
+var offensivePositions = map[string]bool{
+	"QB": true, "RB": true, "HB": true, "FB": true, "TB": true, "WB": true, "BB": true,
+	"WR": true, "SE": true, "FL": true, "TE": true,
+	"LT": true, "LG": true, "C": true, "RG": true, "RT": true, "T": true, "G": true, "OT": true, "OG": true, "OL": true,
+}
+
+var specialTeamsPositions = map[string]bool{
+	"K": true, "P": true, "LS": true, "KR": true, "PR": true,
+}
+
+func GetTeamStarters(url string, tableId string, team string, year int) (TeamStarters, error) {
+	franchise, exists := GetFranchise(team)
+	if !exists {
+		return TeamStarters{}, fmt.Errorf("unknown team %s", team)
+	}
+
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return TeamStarters{}, err
+	}
+
+	starters := TeamStarters{
+		Team:         team,
+		Franchise:    franchise.NameInYear(year),
+		Year:         year,
+		Offense:      []Starter{},
+		Defense:      []Starter{},
+		SpecialTeams: []Starter{},
+	}
+
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		if row["player"] == "" {
+			continue
+		}
+
+		age, _ := strconv.Atoi(row["age"])
+		gamesPlayed, _ := strconv.Atoi(row.first("g", "games"))
+		gamesStarted, _ := strconv.Atoi(row.first("gs", "games_started"))
+		av, _ := strconv.Atoi(row["av"])
+
+		playerId := row["player_id"]
+		if playerId == "" {
+			playerId = idFromHref(row["player_href"])
+		}
+
+		starter := Starter{
+			Position:     row["pos"],
+			PlayerId:     playerId,
+			Name:         row.name("player"),
+			Age:          age,
+			GamesPlayed:  gamesPlayed,
+			GamesStarted: gamesStarted,
+			AV:           av,
+		}
+
+		if offensivePositions[starter.Position] {
+			starters.Offense = append(starters.Offense, starter)
+		} else if specialTeamsPositions[starter.Position] {
+			starters.SpecialTeams = append(starters.SpecialTeams, starter)
+		} else {
+			starters.Defense = append(starters.Defense, starter)
+		}
+	}
+
+	if len(starters.Offense) == 0 && len(starters.Defense) == 0 {
+		return TeamStarters{}, fmt.Errorf("no data found for selected year")
+	}
+
+	return starters, nil
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type VenueSeason struct {
+	Year  int      `json:"year"`
+	Teams []string `json:"teams"`
+}
+
+type Venue struct {
+	VenueId   string        `json:"venueId"`
+	Name      string        `json:"name"`
+	City      string        `json:"city"`
+	State     string        `json:"state"`
+	FirstYear int           `json:"firstYear"`
+	LastYear  int           `json:"lastYear"`
+	Games     int           `json:"games"`
+	Teams     []string      `json:"teams"`
+	HomeTeams []VenueSeason `json:"homeTeams,omitempty"` // only filled by GetVenue
+}
+
+/*
+Gets every stadium PFR has a game for
+Venue ids match GameConditions.StadiumId on boxscores
+*/
+func GetVenues(url string, tableId string) ([]Venue, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return []Venue{}, err
+	}
+
+	venues := []Venue{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		venue := parseVenueRow(row)
+		if venue.VenueId == "" {
+			continue
+		}
+		venues = append(venues, venue)
+	}
+
+	if len(venues) == 0 {
+		return []Venue{}, fmt.Errorf("no venues found")
+	}
+
+	return venues, nil
+}
+
+// Gets a stadium with the teams that played home games there each season
+func GetVenue(url string, venueId string) (Venue, error) {
+	doc, err := fetchDoc(url)
+	if err != nil {
+		return Venue{}, err
+	}
+
+	venue := Venue{
+		VenueId: venueId,
+		Name:    strings.TrimSpace(doc.Find("#meta h1").First().Text()),
+		Teams:   []string{},
+	}
+
+	// Stadium pages hold a single seasons table, its id varies
+	tableId, _ := doc.Find("table[id]").First().Attr("id")
+
+	seasons := map[int]map[string]bool{}
+	for _, row := range readTableRows(findTable(doc, tableId)) {
+		year := row.intStat("year_id")
+		if year == 0 {
+			continue
+		}
+
+		if seasons[year] == nil {
+			seasons[year] = map[string]bool{}
+		}
+		for _, href := range strings.Fields(row.first("team_hrefs", "team_href", "teams_hrefs", "teams_href")) {
+			if team := teamFromHref(href); team != "" {
+				seasons[year][team] = true
+			}
+		}
+		venue.Games += row.intStat("g")
+	}
+
+	if len(seasons) == 0 {
+		return Venue{}, fmt.Errorf("no seasons found for venue %s", venueId)
+	}
+
+	allTeams := map[string]bool{}
+	for year, teams := range seasons {
+		season := VenueSeason{Year: year, Teams: []string{}}
+		for team := range teams {
+			season.Teams = append(season.Teams, team)
+			allTeams[team] = true
+		}
+		sort.Strings(season.Teams)
+		venue.HomeTeams = append(venue.HomeTeams, season)
+	}
+	sort.Slice(venue.HomeTeams, func(i, j int) bool {
+		return venue.HomeTeams[i].Year < venue.HomeTeams[j].Year
+	})
+	for team := range allTeams {
+		venue.Teams = append(venue.Teams, team)
+	}
+	sort.Strings(venue.Teams)
+
+	venue.FirstYear = venue.HomeTeams[0].Year
+	venue.LastYear = venue.HomeTeams[len(venue.HomeTeams)-1].Year
+
+	return venue, nil
+}
+
+func parseVenueRow(row tableRow) Venue {
+	venue := Venue{
+		VenueId:   idFromHref(row.first("stadium_name_href", "stadium_href")),
+		Name:      row.first("stadium_name", "stadium"),
+		City:      row["city"],
+		State:     row["state"],
+		FirstYear: row.intStat("year_min"),
+		LastYear:  row.intStat("year_max"),
+		Games:     row.intStat("g", "games"),
+		Teams:     []string{},
+	}
+
+	for _, href := range strings.Fields(row.first("teams_hrefs", "teams_href")) {
+		if team := teamFromHref(href); team != "" {
+			venue.Teams = append(venue.Teams, team)
+		}
+	}
+
+	return venue
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	handlers "pfr/handlers"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,7 +35,30 @@ func getSeasonOverlook(c *gin.Context) {
 	url := "https://www.pro-football-reference.com/teams/" + team + "/"
 	tableSelector := "#team_index"
 
-	data, err := handlers.GetSeasonOverlook(url, tableSelector, year_int)
+	// Title game pages and the leagues they decide, from 1966 on the Super Bowl decides both
+	type titlePage struct {
+		url     string
+		leagues []string
+	}
+	titlePages := []titlePage{{superBowlPage, []string{"NFL", "AFL"}}}
+	if year_int < 1966 {
+		titlePages = []titlePage{{nflChampionshipPage, []string{"NFL"}}, {aflChampionshipPage, []string{"AFL"}}}
+	}
+
+	// Leagues whose page can't be loaded fall back to the playoff text of the team page
+	titleGames := map[string][]handlers.Championship{}
+	for _, page := range titlePages {
+		championships, err := handlers.GetChampionships(page.url, strings.ToLower(page.leagues[0]))
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		for _, league := range page.leagues {
+			titleGames[league] = championships
+		}
+	}
+
+	data, err := handlers.GetSeasonOverlook(url, tableSelector, team, year_int, titleGames)
 
 	if err != nil {
 		log.Println(err)
@@ -73,6 +97,41 @@ func getDraftYear(c *gin.Context) {
 	c.IndentedJSON(http.StatusOK, data)
 }
 
+/*
+Gets every draft pick by team over a range of years, see "https://www.pro-football-reference.com/teams/buf/draft.htm" as example with param "buf"
+Specify:
+- team (gnb, dal, jax, etc.)
+Optional:
+- from, to (2003, 2024, etc.), defaults to full history
+- group (year, round)
+*/
+func getDraftHistory(c *gin.Context) {
+	team := c.Query("team")
+	from, err := strconv.Atoi(c.DefaultQuery("from", "0"))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	to, err := strconv.Atoi(c.DefaultQuery("to", "9999"))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/draft.htm"
+	tableSelector := "#draft"
+
+	data, err := handlers.GetDraftHistory(url, tableSelector, from, to, c.Query("group"))
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
 /*
 Gets offensive stats by team and year, see "https://www.pro-football-reference.com/teams/rav/2024.htm" first table as example with param "rav"
 Specify:
@@ -162,19 +221,88 @@ func getTeamDefensiveRankings(c *gin.Context) {
 }
 
 /*
+Gets full season roster by team and year, see "https://www.pro-football-reference.com/teams/gnb/2010_roster.htm" roster table as example with param "gnb"
+Specify:
+- team (gnb, dal, jax, etc.)
+- season (2003, 2024, etc.)
+*/
+func getTeamRoster(c *gin.Context) {
+	team := c.Query("team")
+	year := c.Query("year")
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_roster.htm"
+	tableId := "roster"
 
--------------------- SEASON --------------------
+	data, err := handlers.GetTeamRoster(url, tableId)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets offense, defense and special teams starters by team and year, see "https://www.pro-football-reference.com/teams/gnb/2010_roster.htm" starters table as example with param "gnb"
+Specify:
+- team (gnb, dal, jax, etc.)
+- season (2003, 2024, etc.)
+*/
+func getTeamStarters(c *gin.Context) {
+	team := c.Query("team")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_roster.htm"
+	tableId := "starters"
+
+	data, err := handlers.GetTeamStarters(url, tableId, team, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
 
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets per-player stat table by team and year, see "https://www.pro-football-reference.com/teams/gnb/2010.htm" player tables as example with param "gnb"
+Specify:
+- category (passing, rushing_and_receiving, defense, kicking, punting, returns, scoring)
+- team (gnb, dal, jax, etc.)
+- season (2003, 2024, etc.)
 */
+func getTeamPlayerStats(c *gin.Context) {
+	category := c.Param("category")
+	team := c.Query("team")
+	year := c.Query("year")
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + ".htm"
+
+	data, err := handlers.GetTeamPlayerStats(url, category)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
 
 /*
-Gets standings by division, see "https://www.pro-football-reference.com/years/2022/" first table as example with param 2022
+Gets third down, fourth down and red zone conversions by team and year, see "https://www.pro-football-reference.com/teams/gnb/2010.htm" team conversions table as example with param "gnb"
 Specify:
+- team (gnb, dal, jax, etc.)
 - season (2003, 2024, etc.)
 */
-func getDivisionStandings(c *gin.Context) {
+func getTeamConversions(c *gin.Context) {
+	team := c.Query("team")
 	year := c.Query("year")
-	url := "https://www.pro-football-reference.com/years/" + year + "/"
 	yearInt, err := strconv.Atoi(year)
 
 	if err != nil {
@@ -182,37 +310,186 @@ func getDivisionStandings(c *gin.Context) {
 		return
 	}
 
-	if yearInt < 1970 {
-		data, err := handlers.GetLeagueStandingsByYearPre1970(url)
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + ".htm"
+	tableId := "team_conversions"
 
-		if err != nil {
-			log.Println(err)
-			return
-		}
+	data, err := handlers.GetTeamConversions(url, tableId, yearInt, team)
 
-		c.IndentedJSON(http.StatusOK, data)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets every head coach by team and season, see "https://www.pro-football-reference.com/teams/gnb/coaches.htm" as example with param "gnb"
+Specify:
+- team (gnb, dal, jax, etc.)
+*/
+func getTeamCoaches(c *gin.Context) {
+	team := c.Query("team")
+	url := "https://www.pro-football-reference.com/teams/" + team + "/coaches.htm"
+	tableId := "coaches"
+
+	data, err := handlers.GetTeamCoaches(url, tableId, team)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets advanced stats by team and year, see "https://www.pro-football-reference.com/teams/gnb/2022_advanced.htm" as example with param "gnb"
+Specify:
+- category (passing, rushing, receiving, defense)
+- team (gnb, dal, jax, etc.)
+- season (2018 on)
+*/
+func getTeamAdvancedStats(c *gin.Context) {
+	category := c.Param("category")
+	team := c.Query("team")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_advanced.htm"
+	data, err := handlers.GetAdvancedStats(url, category, yearInt, team)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets spread, total and ATS results per game with a season summary, see "https://www.pro-football-reference.com/teams/kan/2023_lines.htm" as example with params "kan", 2023
+Specify:
+- team (gnb, dal, jax, etc.)
+- season (2003, 2024, etc.)
+*/
+func getTeamBetting(c *gin.Context) {
+	team := c.Query("team")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_lines.htm"
+	tableId := "vegas_lines"
+
+	data, err := handlers.GetTeamBetting(url, tableId, team, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets offense, defense and special teams snaps per player, see "https://www.pro-football-reference.com/teams/kan/2023-snap-counts.htm" as example with params "kan", 2023
+Specify:
+- team (gnb, dal, jax, etc.)
+- season (2012 on)
+Optional:
+- game (boxscore id, e.g. 202309070kan) for a single game
+*/
+func getTeamSnapCounts(c *gin.Context) {
+	team := c.Query("team")
+	year := c.Query("year")
+	game := c.Query("game")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	var data handlers.TeamSnapCounts
+	if game != "" {
+		url := "https://www.pro-football-reference.com/boxscores/" + game + ".htm"
+		data, err = handlers.GetGameSnapCounts(url, team, yearInt, game)
 	} else {
-		data, err := handlers.GetLeagueStandingsByYearPost1970(url)
+		url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "-snap-counts.htm"
+		tableId := "snap_counts"
+		data, err = handlers.GetTeamSnapCounts(url, tableId, team, yearInt)
+	}
 
-		if err != nil {
-			log.Println(err)
-			return
-		}
+	if err != nil {
+		log.Println(err)
+		return
+	}
 
-		c.IndentedJSON(http.StatusOK, data)
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets weekly injury report entries with game status, see "https://www.pro-football-reference.com/teams/kan/2023_injuries.htm" as example with params "kan", 2023
+Specify:
+- team (gnb, dal, jax, etc.)
+- season (2003, 2024, etc.)
+*/
+func getTeamInjuries(c *gin.Context) {
+	team := c.Query("team")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_injuries.htm"
+	tableId := "team_injuries"
+
+	data, err := handlers.GetTeamInjuries(url, tableId, team, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
 	}
+
+	c.IndentedJSON(http.StatusOK, data)
 }
 
-// **** Award winners are generated dynamically, this script gets placeholder values which are correct as of 3/5/2025 ****
 /*
-Gets list of award winners for a season, see "https://www.pro-football-reference.com/years/2003/" award winners list with param 2003
+Gets game and situational splits, see "https://www.pro-football-reference.com/teams/kan/2023_splits.htm" as example with params "kan", 2023
 Specify:
+- team (gnb, dal, jax, etc.)
 - season (2003, 2024, etc.)
+Optional:
+- category (Place, Result, Quarter, Down, Yards To Go, Field Position, etc.)
 */
-func getSeasonAwardWinners(c *gin.Context) {
+func getTeamSplits(c *gin.Context) {
+	team := c.Query("team")
 	year := c.Query("year")
-	url := "https://www.pro-football-reference.com/years/" + year + "/"
-	data, err := handlers.GetSeasonAwardWinners(url)
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/teams/" + team + "/" + year + "_splits.htm"
+	gameTableId := "game_splits"
+	situationalTableId := "situational_splits"
+
+	data, err := handlers.GetTeamSplits(url, gameTableId, situationalTableId, team, yearInt, c.Query("category"))
 
 	if err != nil {
 		log.Println(err)
@@ -222,20 +499,699 @@ func getSeasonAwardWinners(c *gin.Context) {
 	c.IndentedJSON(http.StatusOK, data)
 }
 
-func main() {
-	router := gin.Default()
+/*
+Gets registry of active franchises with their PFR codes and historical names, see teams.txt
+*/
+func getFranchises(c *gin.Context) {
+	c.IndentedJSON(http.StatusOK, handlers.GetFranchises())
+}
 
-	// Team
-	router.GET("/team/", getSeasonOverlook)                         // ?team=___&year=___
-	router.GET("/team/draft", getDraftYear)                         // ?team=___&year=___
-	router.GET("/team/offensiveStats", getTeamOffensiveStats)       // ?team=___&year=___
-	router.GET("/team/defensiveStats", getTeamDefensiveStats)       // ?team=___&year=___
-	router.GET("/team/offensiveRankings", getTeamOffensiveRankings) // ?team=___&year=___
-	router.GET("/team/defensiveRankings", getTeamDefensiveRankings) // ?team=___&year=___
+/*
+Gets all-time series between two franchises with every meeting, see "https://www.pro-football-reference.com/teams/gnb/head-to-head/chi.htm" as example with params "gnb", "chi"
+Specify:
+- team, opponent (gnb, chi, oti, etc.), relocated franchises use their current code
+*/
+func getHeadToHead(c *gin.Context) {
+	team := c.Param("team")
+	opponent := c.Param("opponent")
+	url := "https://www.pro-football-reference.com/teams/" + team + "/head-to-head/" + opponent + ".htm"
+	tableId := "games"
 
-	// Season
-	router.GET("/season/divStandings", getDivisionStandings) // ?year=___
-	router.GET("/season/awards", getSeasonAwardWinners)      // ?year=___
+	data, err := handlers.GetHeadToHead(url, tableId, team, opponent)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- SEASON --------------------
+
+*/
+
+/*
+Gets standings by division, see "https://www.pro-football-reference.com/years/2022/" first table as example with param 2022
+Seasons before 1970 return every league on the page (NFL, AFL, AAFC, etc.) tagged by league
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getDivisionStandings(c *gin.Context) {
+	year := c.Query("year")
+	url := "https://www.pro-football-reference.com/years/" + year + "/"
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if yearInt < 1970 {
+		data, err := handlers.GetLeagueStandingsByYearPre1970(url)
+
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		c.IndentedJSON(http.StatusOK, data)
+	} else {
+		data, err := handlers.GetLeagueStandingsByYearPost1970(url)
+
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		c.IndentedJSON(http.StatusOK, data)
+	}
+}
+
+/*
+Gets every pick of a draft, see "https://www.pro-football-reference.com/years/2010/draft.htm" as example with param 2010
+Specify:
+- season (2003, 2024, etc.)
+Optional:
+- round (1, 2, etc.)
+- position (QB, WR, etc.)
+- team (gnb, dal, jax, etc.)
+- college (Alabama, Ohio St., etc.)
+*/
+func getLeagueDraft(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	round, _ := strconv.Atoi(c.Query("round"))
+	filter := handlers.DraftFilter{
+		Round:    round,
+		Position: c.Query("position"),
+		Team:     c.Query("team"),
+		College:  c.Query("college"),
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/draft.htm"
+	tableId := "drafts"
+
+	data, err := handlers.GetLeagueDraft(url, tableId, yearInt, filter)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets NFL Scouting Combine results, see "https://www.pro-football-reference.com/draft/2010-combine.htm" as example with param 2010
+Specify:
+- season (2003, 2024, etc.)
+Optional:
+- position (QB, WR, etc.)
+- metric (forty, vertical, bench, broad, cone, shuttle, weight, height) with min and/or max
+*/
+func getCombineResults(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	minValue, _ := strconv.ParseFloat(c.Query("min"), 64)
+	maxValue, _ := strconv.ParseFloat(c.Query("max"), 64)
+	filter := handlers.CombineFilter{
+		Position: c.Query("position"),
+		Metric:   c.Query("metric"),
+		Min:      minValue,
+		Max:      maxValue,
+	}
+
+	url := "https://www.pro-football-reference.com/draft/" + year + "-combine.htm"
+	tableId := "combine"
+
+	data, err := handlers.GetCombineResults(url, tableId, yearInt, filter)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets playoff bracket with seeds, rounds and results, see "https://www.pro-football-reference.com/years/2022/" playoff results table as example with param 2022
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getSeasonPlayoffs(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/"
+	data, err := handlers.GetSeasonPlayoffs(url, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets offense and defense stats for every team in a season, see "https://www.pro-football-reference.com/years/2022/" and "https://www.pro-football-reference.com/years/2022/opp.htm" team stats tables as example with param 2022
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getSeasonTeamStats(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	offenseUrl := "https://www.pro-football-reference.com/years/" + year + "/"
+	defenseUrl := "https://www.pro-football-reference.com/years/" + year + "/opp.htm"
+
+	data, err := handlers.GetSeasonTeamStats(offenseUrl, defenseUrl, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets every player's season in a stat category, see "https://www.pro-football-reference.com/years/2022/passing.htm" as example with params 2022, passing
+Specify:
+- season (2003, 2024, etc.)
+- category (passing, rushing, receiving, defense, kicking, punting, returns, scoring)
+Optional:
+- team (gnb, dal, jax, etc.)
+- position (QB, WR, etc.)
+- minAttempts (attempts, targets, games, etc. depending on category)
+- sort (any PFR column id, e.g. pass_yds) and order (asc, desc)
+*/
+func getSeasonLeaders(c *gin.Context) {
+	year := c.Query("year")
+	category := c.Query("category")
+	minAttempts, _ := strconv.Atoi(c.Query("minAttempts"))
+
+	filter := handlers.LeaderFilter{
+		Team:        c.Query("team"),
+		Position:    c.Query("position"),
+		MinAttempts: minAttempts,
+		SortBy:      c.Query("sort"),
+		Ascending:   c.Query("order") == "asc",
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/" + category + ".htm"
+	data, err := handlers.GetSeasonLeaders(url, category, filter)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets league-wide advanced stats, see "https://www.pro-football-reference.com/years/2022/passing_advanced.htm" as example with params 2022, passing
+Specify:
+- category (passing, rushing, receiving, defense)
+- season (2018 on)
+*/
+func getSeasonAdvancedStats(c *gin.Context) {
+	category := c.Param("category")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/" + category + "_advanced.htm"
+	data, err := handlers.GetAdvancedStats(url, category, yearInt, "")
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets every game in a season or week, scheduled games are included with played false, see "https://www.pro-football-reference.com/years/2022/games.htm" as example with param 2022
+Specify:
+- season (2003, 2024, etc.)
+Optional:
+- week (1, 2, etc. or WildCard, Division, ConfChamp, SuperBowl)
+- conditions (true) adds stadium, surface and weather from each game's boxscore, one extra request per game so week is required
+*/
+func getSeasonGames(c *gin.Context) {
+	year := c.Query("year")
+	week := c.Query("week")
+	withConditions := c.Query("conditions") == "true"
+
+	// A full season is ~285 boxscores, far past PFR's rate limit
+	if withConditions && week == "" {
+		log.Println("conditions requires a week")
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/games.htm"
+	tableId := "games"
+
+	data, err := handlers.GetSeasonGames(url, tableId, week)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	if withConditions {
+		for i := range data {
+			// Scheduled games have no boxscore yet
+			if data[i].BoxscoreId == "" {
+				continue
+			}
+
+			boxscoreUrl := "https://www.pro-football-reference.com/boxscores/" + data[i].BoxscoreId + ".htm"
+			conditions, err := handlers.GetGameConditions(boxscoreUrl)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			data[i].Conditions = &conditions
+		}
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets All-Pro selections by organization, see "https://www.pro-football-reference.com/years/2022/allpro.htm" as example with param 2022
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getSeasonAllPros(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/allpro.htm"
+	tableId := "all_pro"
+
+	data, err := handlers.GetSeasonSelections(url, tableId, "allpro", yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets Pro Bowl selections, see "https://www.pro-football-reference.com/years/2022/probowl.htm" as example with param 2022
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getSeasonProBowls(c *gin.Context) {
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/years/" + year + "/probowl.htm"
+	tableId := "pro_bowl"
+
+	data, err := handlers.GetSeasonSelections(url, tableId, "probowl", yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+const (
+	superBowlPage       = "https://www.pro-football-reference.com/super-bowl/"
+	nflChampionshipPage = "https://www.pro-football-reference.com/nfl-championship/"
+	aflChampionshipPage = "https://www.pro-football-reference.com/afl-championship/"
+)
+
+// League -> PFR championship game pages, in response order
+var championshipPages = []struct {
+	league string
+	url    string
+}{
+	{"nfl", superBowlPage},
+	{"nfl", nflChampionshipPage},
+	{"afl", aflChampionshipPage},
+}
+
+/*
+Gets every Super Bowl and pre-merger championship game, see "https://www.pro-football-reference.com/super-bowl/" as example
+Optional:
+- league (nfl, afl), defaults to both
+*/
+func getChampionships(c *gin.Context) {
+	league := c.Query("league")
+
+	data := []handlers.Championship{}
+	for _, page := range championshipPages {
+		if league != "" && league != page.league {
+			continue
+		}
+
+		championships, err := handlers.GetChampionships(page.url, page.league)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		data = append(data, championships...)
+	}
+
+	if len(data) == 0 {
+		log.Println("unknown league " + league)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+// **** Award winners are generated dynamically, this script gets placeholder values which are correct as of 3/5/2025 ****
+/*
+Gets list of award winners for a season, see "https://www.pro-football-reference.com/years/2003/" award winners list with param 2003
+Specify:
+- season (2003, 2024, etc.)
+*/
+func getSeasonAwardWinners(c *gin.Context) {
+	year := c.Query("year")
+	url := "https://www.pro-football-reference.com/years/" + year + "/"
+	data, err := handlers.GetSeasonAwardWinners(url)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- AWARDS --------------------
+
+*/
+
+/*
+Gets every winner of an award over a range of seasons, see "https://www.pro-football-reference.com/awards/ap-nfl-mvp-award.htm" as example with id "ap-nfl-mvp-award"
+Specify:
+- awardId (ap-nfl-mvp-award, ap-defensive-player-of-the-year, etc.)
+Optional:
+- from, to (2003, 2024, etc.), defaults to full history
+*/
+func getAwardHistory(c *gin.Context) {
+	awardId := c.Param("awardId")
+	from, err := strconv.Atoi(c.DefaultQuery("from", "0"))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	to, err := strconv.Atoi(c.DefaultQuery("to", "9999"))
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/awards/" + awardId + ".htm"
+	data, err := handlers.GetAwardHistory(url, awardId, from, to)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- HALL OF FAME --------------------
+
+*/
+
+/*
+Gets Hall of Fame inductees for an induction class, see "https://www.pro-football-reference.com/hof/" as example
+Specify:
+- induction year (2003, 2024, etc.)
+*/
+func getHallOfFame(c *gin.Context) {
+	year, err := strconv.Atoi(c.Query("year"))
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/hof/"
+	tableId := "hof_players"
+
+	data, err := handlers.GetHallOfFame(url, tableId, handlers.HallOfFameFilter{Year: year})
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Searches every Hall of Fame inductee, see "https://www.pro-football-reference.com/hof/" as example
+Optional:
+- name (partial match)
+- position (QB, Coach, Contributor, etc.)
+- team (gnb, dal, jax, etc.)
+- from, to (induction years)
+*/
+func searchHallOfFame(c *gin.Context) {
+	from, _ := strconv.Atoi(c.Query("from"))
+	to, _ := strconv.Atoi(c.Query("to"))
+
+	filter := handlers.HallOfFameFilter{
+		Name:     c.Query("name"),
+		Position: c.Query("position"),
+		Team:     c.Query("team"),
+		From:     from,
+		To:       to,
+	}
+
+	url := "https://www.pro-football-reference.com/hof/"
+	tableId := "hof_players"
+
+	data, err := handlers.GetHallOfFame(url, tableId, filter)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- VENUES --------------------
+
+*/
+
+/*
+Gets every stadium with its home teams, see "https://www.pro-football-reference.com/stadiums/" as example
+*/
+func getVenues(c *gin.Context) {
+	url := "https://www.pro-football-reference.com/stadiums/"
+	tableId := "stadiums"
+
+	data, err := handlers.GetVenues(url, tableId)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+Gets a stadium with its home teams by season, see "https://www.pro-football-reference.com/stadiums/KAN00.htm" as example with id "KAN00"
+Specify:
+- venueId (KAN00, GNB00, etc.)
+*/
+func getVenue(c *gin.Context) {
+	venueId := c.Param("venueId")
+	url := "https://www.pro-football-reference.com/stadiums/" + venueId + ".htm"
+
+	data, err := handlers.GetVenue(url, venueId)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- COACH --------------------
+
+*/
+
+/*
+Gets head coaching record and coordinators for a coach, see "https://www.pro-football-reference.com/coaches/QuinDa0.htm" as example with id "QuinDa0"
+Specify:
+- coachId (QuinDa0, BeliBi0, etc.)
+*/
+func getCoach(c *gin.Context) {
+	coachId := c.Param("coachId")
+	url := "https://www.pro-football-reference.com/coaches/" + coachId + ".htm"
+
+	data, err := handlers.GetCoach(url, coachId)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+/*
+
+-------------------- PLAYER --------------------
+
+*/
+
+/*
+Gets regular season and playoff game logs for a player, see "https://www.pro-football-reference.com/players/M/MahoPa00/gamelog/2023/" as example with id "MahoPa00"
+Specify:
+- id (MahoPa00, BradTo00, etc.)
+- season (2003, 2024, etc.)
+*/
+func getPlayerGameLog(c *gin.Context) {
+	id := c.Param("id")
+	year := c.Query("year")
+	yearInt, err := strconv.Atoi(year)
+
+	if err != nil || len(id) == 0 {
+		log.Println(err)
+		return
+	}
+
+	url := "https://www.pro-football-reference.com/players/" + id[0:1] + "/" + id + "/gamelog/" + year + "/"
+	data, err := handlers.GetPlayerGameLog(url, id, yearInt)
+
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, data)
+}
+
+func main() {
+	router := gin.Default()
+
+	// Team
+	router.GET("/team/", getSeasonOverlook)                         // ?team=___&year=___
+	router.GET("/team/draft", getDraftYear)                         // ?team=___&year=___
+	router.GET("/team/draft/history", getDraftHistory)              // ?team=___&from=___&to=___&group=___
+	router.GET("/team/offensiveStats", getTeamOffensiveStats)       // ?team=___&year=___
+	router.GET("/team/defensiveStats", getTeamDefensiveStats)       // ?team=___&year=___
+	router.GET("/team/offensiveRankings", getTeamOffensiveRankings) // ?team=___&year=___
+	router.GET("/team/defensiveRankings", getTeamDefensiveRankings) // ?team=___&year=___
+	router.GET("/team/roster", getTeamRoster)                       // ?team=___&year=___
+	router.GET("/team/starters", getTeamStarters)                   // ?team=___&year=___
+	router.GET("/team/players/:category", getTeamPlayerStats)       // ?team=___&year=___
+	router.GET("/team/conversions", getTeamConversions)             // ?team=___&year=___
+	router.GET("/team/coaches", getTeamCoaches)                     // ?team=___
+	router.GET("/team/advanced/:category", getTeamAdvancedStats)    // ?team=___&year=___
+	router.GET("/team/betting", getTeamBetting)                     // ?team=___&year=___
+	router.GET("/team/snapcounts", getTeamSnapCounts)               // ?team=___&year=___&game=___
+	router.GET("/team/injuries", getTeamInjuries)                   // ?team=___&year=___
+	router.GET("/team/splits", getTeamSplits)                       // ?team=___&year=___&category=___
+	router.GET("/teams", getFranchises)
+	router.GET("/teams/:team/vs/:opponent", getHeadToHead)
+
+	// Season
+	router.GET("/season/divStandings", getDivisionStandings)         // ?year=___
+	router.GET("/season/awards", getSeasonAwardWinners)              // ?year=___
+	router.GET("/season/playoffs", getSeasonPlayoffs)                // ?year=___
+	router.GET("/season/teamStats", getSeasonTeamStats)              // ?year=___
+	router.GET("/season/advanced/:category", getSeasonAdvancedStats) // ?year=___
+	router.GET("/season/games", getSeasonGames)                      // ?year=___&week=___&conditions=___
+	router.GET("/season/allpro", getSeasonAllPros)                   // ?year=___
+	router.GET("/season/probowl", getSeasonProBowls)                 // ?year=___
+	router.GET("/season/leaders", getSeasonLeaders)                  // ?year=___&category=___&team=___&position=___&minAttempts=___&sort=___&order=___
+
+	// Draft
+	router.GET("/draft", getLeagueDraft)      // ?year=___&round=___&position=___&team=___&college=___
+	router.GET("/combine", getCombineResults) // ?year=___&position=___&metric=___&min=___&max=___
+
+	// Championships
+	router.GET("/championships", getChampionships) // ?league=___
+
+	// Awards
+	router.GET("/awards/:awardId", getAwardHistory) // ?from=___&to=___
+
+	// Hall of Fame
+	router.GET("/hof", getHallOfFame)           // ?year=___
+	router.GET("/hof/search", searchHallOfFame) // ?name=___&position=___&team=___&from=___&to=___
+
+	// Venues
+	router.GET("/venues", getVenues)
+	router.GET("/venues/:venueId", getVenue)
+
+	// Coach
+	router.GET("/coaches/:coachId", getCoach)
+
+	// Player
+	router.GET("/player/:id/gamelog", getPlayerGameLog) // ?year=___
 
 	router.Run()
 }